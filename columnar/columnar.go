@@ -0,0 +1,205 @@
+// Package columnar provides record Batches stored as typed columns, and
+// operators which process an Observable of Batches a batch at a time rather
+// than an item at a time, for analytics-style aggregations.
+package columnar
+
+import (
+	"fmt"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+)
+
+// Batch is a set of named columns of equal length. Every column is a typed
+// slice, one of []float64, []int64, []string or []bool.
+type Batch struct {
+	names   []string
+	columns []interface{}
+	length  int
+}
+
+// NewBatch creates a Batch from names and their columns.
+func NewBatch(names []string, columns ...interface{}) (*Batch, error) {
+	if len(names) != len(columns) {
+		return nil, errors.New(errors.ObservableError, "names and columns differ in number")
+	}
+
+	b := &Batch{names: names, columns: columns}
+	for i, column := range columns {
+		n, err := columnLen(column)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			b.length = n
+		} else if n != b.length {
+			msg := fmt.Sprintf("column %s has %d rows instead of %d", names[i], n, b.length)
+			return nil, errors.New(errors.ObservableError, msg)
+		}
+	}
+	return b, nil
+}
+
+func columnLen(column interface{}) (int, error) {
+	switch column := column.(type) {
+	case []float64:
+		return len(column), nil
+	case []int64:
+		return len(column), nil
+	case []string:
+		return len(column), nil
+	case []bool:
+		return len(column), nil
+	default:
+		return 0, errors.New(errors.ObservableError, fmt.Sprintf("unsupported column type %T", column))
+	}
+}
+
+// Len returns the number of rows of a Batch.
+func (b *Batch) Len() int {
+	return b.length
+}
+
+// Names returns the names of the columns of a Batch.
+func (b *Batch) Names() []string {
+	return b.names
+}
+
+// Column returns the named column, or nil if there is none.
+func (b *Batch) Column(name string) interface{} {
+	for i, n := range b.names {
+		if n == name {
+			return b.columns[i]
+		}
+	}
+	return nil
+}
+
+// Float64s returns the named []float64 column, or nil if there is none.
+func (b *Batch) Float64s(name string) []float64 {
+	column, _ := b.Column(name).([]float64)
+	return column
+}
+
+// Int64s returns the named []int64 column, or nil if there is none.
+func (b *Batch) Int64s(name string) []int64 {
+	column, _ := b.Column(name).([]int64)
+	return column
+}
+
+// Strings returns the named []string column, or nil if there is none.
+func (b *Batch) Strings(name string) []string {
+	column, _ := b.Column(name).([]string)
+	return column
+}
+
+// Bools returns the named []bool column, or nil if there is none.
+func (b *Batch) Bools(name string) []bool {
+	column, _ := b.Column(name).([]bool)
+	return column
+}
+
+// Select returns a new Batch with the rows whose mask is true. The mask must
+// have as many values as the Batch has rows.
+func (b *Batch) Select(mask []bool) (*Batch, error) {
+	if len(mask) != b.length {
+		msg := fmt.Sprintf("mask has %d values instead of %d", len(mask), b.length)
+		return nil, errors.New(errors.ObservableError, msg)
+	}
+
+	selected := &Batch{names: b.names, columns: make([]interface{}, len(b.columns))}
+	for i, column := range b.columns {
+		switch column := column.(type) {
+		case []float64:
+			kept := []float64{}
+			for row, v := range column {
+				if mask[row] {
+					kept = append(kept, v)
+				}
+			}
+			selected.columns[i] = kept
+		case []int64:
+			kept := []int64{}
+			for row, v := range column {
+				if mask[row] {
+					kept = append(kept, v)
+				}
+			}
+			selected.columns[i] = kept
+		case []string:
+			kept := []string{}
+			for row, v := range column {
+				if mask[row] {
+					kept = append(kept, v)
+				}
+			}
+			selected.columns[i] = kept
+		case []bool:
+			kept := []bool{}
+			for row, v := range column {
+				if mask[row] {
+					kept = append(kept, v)
+				}
+			}
+			selected.columns[i] = kept
+		}
+	}
+	for _, keep := range mask {
+		if keep {
+			selected.length++
+		}
+	}
+	return selected, nil
+}
+
+// Map applies fn to every Batch of an Observable and returns a new Observable
+// of the resulting Batches.
+func Map(o observable.Observable, fn func(*Batch) *Batch) observable.Observable {
+	return apply(o, func(b *Batch) (*Batch, error) {
+		return fn(b), nil
+	})
+}
+
+// Filter keeps the rows of every Batch of an Observable for which the mask
+// computed by fn is true, and drops the Batches left empty. A mask whose
+// length differs from the number of rows emits an error.
+func Filter(o observable.Observable, fn func(*Batch) []bool) observable.Observable {
+	return apply(o, func(b *Batch) (*Batch, error) {
+		selected, err := b.Select(fn(b))
+		if err != nil || selected.Len() == 0 {
+			return nil, err
+		}
+		return selected, nil
+	})
+}
+
+// apply maps the Batches of an Observable, dropping nil results and passing
+// errors on.
+func apply(o observable.Observable, fn func(*Batch) (*Batch, error)) observable.Observable {
+	out := make(chan interface{})
+	observable.Link(out, o)
+	go func() {
+	OuterLoop:
+		for item := range o {
+			switch item := item.(type) {
+			case error:
+				out <- item
+				break OuterLoop
+			case *Batch:
+				b, err := fn(item)
+				if err != nil {
+					out <- err
+					break OuterLoop
+				}
+				if b != nil {
+					out <- b
+				}
+			default:
+				out <- errors.New(errors.ObservableError, "item is not a *Batch")
+				break OuterLoop
+			}
+		}
+		observable.CloseOut(out)
+	}()
+	return observable.Observable(out)
+}
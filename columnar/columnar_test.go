@@ -0,0 +1,107 @@
+package columnar
+
+import (
+	"testing"
+
+	"github.com/reactivex/rxgo/observable"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBatch(t *testing.T) {
+	b, err := NewBatch([]string{"host", "load"}, []string{"a", "b"}, []float64{0.5, 1.5})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, b.Len())
+		assert.Equal(t, []string{"host", "load"}, b.Names())
+		assert.Equal(t, []float64{0.5, 1.5}, b.Float64s("load"))
+		assert.Nil(t, b.Int64s("load"))
+		assert.Nil(t, b.Column("missing"))
+	}
+
+	_, err = NewBatch([]string{"a", "b"}, []int64{1}, []bool{true, false})
+	assert.Error(t, err)
+
+	_, err = NewBatch([]string{"a"}, []int{1})
+	assert.Error(t, err)
+
+	_, err = NewBatch([]string{"a"})
+	assert.Error(t, err)
+}
+
+func TestBatchSelect(t *testing.T) {
+	b, _ := NewBatch([]string{"id", "name", "ok", "score"},
+		[]int64{1, 2, 3}, []string{"x", "y", "z"}, []bool{true, false, true}, []float64{1, 2, 3})
+
+	selected, err := b.Select([]bool{true, false, true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, selected.Len())
+		assert.Equal(t, []int64{1, 3}, selected.Int64s("id"))
+		assert.Equal(t, []string{"x", "z"}, selected.Strings("name"))
+		assert.Equal(t, []bool{true, true}, selected.Bools("ok"))
+		assert.Equal(t, []float64{1, 3}, selected.Float64s("score"))
+	}
+
+	_, err = b.Select([]bool{true})
+	assert.Error(t, err)
+
+	_, err = b.Select([]bool{true, false, true, true})
+	assert.Error(t, err)
+}
+
+func TestMapAndFilter(t *testing.T) {
+	b1, _ := NewBatch([]string{"v"}, []float64{1, 2, 3})
+	b2, _ := NewBatch([]string{"v"}, []float64{-1, -2})
+
+	double := func(b *Batch) *Batch {
+		vs := b.Float64s("v")
+		doubled := make([]float64, len(vs))
+		for i, v := range vs {
+			doubled[i] = v * 2
+		}
+		result, _ := NewBatch([]string{"v"}, doubled)
+		return result
+	}
+
+	positive := func(b *Batch) []bool {
+		mask := make([]bool, b.Len())
+		for i, v := range b.Float64s("v") {
+			mask[i] = v > 2
+		}
+		return mask
+	}
+
+	results := [][]float64{}
+	for item := range Filter(Map(observable.Just(b1, b2), double), positive) {
+		results = append(results, item.(*Batch).Float64s("v"))
+	}
+	assert.Equal(t, [][]float64{{4, 6}}, results)
+}
+
+func TestMapWithNonBatchItem(t *testing.T) {
+	identity := func(b *Batch) *Batch {
+		return b
+	}
+
+	items := []interface{}{}
+	for item := range Map(observable.Just("row"), identity) {
+		items = append(items, item)
+	}
+	if assert.Len(t, items, 1) {
+		assert.Implements(t, (*error)(nil), items[0])
+	}
+}
+
+func TestFilterWithShortMask(t *testing.T) {
+	b, _ := NewBatch([]string{"v"}, []float64{1, 2, 3})
+	short := func(b *Batch) []bool {
+		return []bool{true}
+	}
+
+	items := []interface{}{}
+	for item := range Filter(observable.Just(b), short) {
+		items = append(items, item)
+	}
+	if assert.Len(t, items, 1) {
+		assert.Implements(t, (*error)(nil), items[0])
+	}
+}
@@ -317,3 +317,243 @@ func (co Connectable) DistinctUntilChanged(apply fx.KeySelectorFunc) Connectable
 	}()
 	return Connectable{Observable: out}
 }
+
+// ConnectableObservable multicasts the items of a source Observable to all of
+// its subscribers, but only starts reading the source once Connect is called,
+// so that every subscriber can be in place before the first item.
+//
+// Each subscriber is queued without bound, so that a slow subscriber never
+// stalls the others.
+type ConnectableObservable struct {
+	source      observable.Observable
+	mu          sync.Mutex
+	subscribers []chan<- interface{}
+	quit        chan struct{}
+	done        bool
+	err         error
+	sub         subscription.Subscription
+
+	replay bool
+	size   int
+	budget *observable.Budget
+	cache  []interface{}
+}
+
+// Publish returns a ConnectableObservable sharing an Observable between its
+// subscribers.
+func Publish(o observable.Observable) *ConnectableObservable {
+	return &ConnectableObservable{source: o}
+}
+
+// Replay returns a ConnectableObservable sharing an Observable between its
+// subscribers, which replays up to bufferSize of the items already read, or
+// all of them if bufferSize is zero, to every new subscriber, even once the
+// source has completed. It lets an expensive source be read once.
+// Cached items are held against the observable.Budget.
+func Replay(o observable.Observable, bufferSize int) *ConnectableObservable {
+	return &ConnectableObservable{
+		source: o,
+		replay: true,
+		size:   bufferSize,
+		budget: observable.CurrentBudget(),
+	}
+}
+
+// Observable returns a new Observable receiving the items of the source from
+// now on, preceded by the cached items if it replays. Once the source has
+// completed, it only receives those and the error, if any.
+func (c *ConnectableObservable) Observable() observable.Observable {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, out := c.add()
+	return out
+}
+
+// Subscribe subscribes an EventHandler to the items of the source from now on
+// and returns a Subscription channel.
+func (c *ConnectableObservable) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	return c.Observable().Subscribe(handler)
+}
+
+// Connect starts reading the source and multicasting its items. Calling it
+// again while connected has no effect and returns the same Subscription.
+// Disposing of the Subscription disconnects the source, leaving the unread
+// items in it; Connect may then be called again.
+func (c *ConnectableObservable) Connect() subscription.Subscription {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connect()
+	return c.sub
+}
+
+// add registers a new subscriber; c.mu must be held.
+func (c *ConnectableObservable) add() (chan<- interface{}, observable.Observable) {
+	in, out := observable.Unbounded()
+	for _, item := range c.cache {
+		in <- item
+	}
+	if c.err != nil {
+		in <- c.err
+	}
+	if c.done {
+		close(in)
+		return nil, out
+	}
+	c.subscribers = append(c.subscribers, in)
+	return in, out
+}
+
+// remove completes a subscriber and unregisters it; c.mu must be held.
+func (c *ConnectableObservable) remove(in chan<- interface{}) {
+	for i, sub := range c.subscribers {
+		if sub == in {
+			close(sub)
+			c.subscribers = append(c.subscribers[:i], c.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// connect starts reading the source unless it is already; c.mu must be held.
+func (c *ConnectableObservable) connect() {
+	if c.quit != nil || c.done {
+		return
+	}
+	quit := make(chan struct{})
+	c.quit = quit
+	c.sub = subscription.New().Subscribe()
+	c.sub.OnDispose(func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// A later connection must not be disconnected.
+		if c.quit == quit {
+			c.disconnect()
+		}
+	})
+
+	go func() {
+	OuterLoop:
+		for {
+			var item interface{}
+			var ok bool
+			select {
+			case <-quit:
+				return
+			case item, ok = <-c.source:
+			}
+
+			c.mu.Lock()
+			select {
+			case <-quit:
+				// Disconnected while the item was read: nobody is left
+				// to receive it.
+				c.mu.Unlock()
+				return
+			default:
+			}
+
+			if !ok {
+				break OuterLoop
+			}
+			if _, isErr := item.(error); !isErr && c.replay {
+				if err := c.store(item); err != nil {
+					item = err
+				}
+			}
+			for _, sub := range c.subscribers {
+				sub <- item
+			}
+			if err, isErr := item.(error); isErr {
+				c.err = err
+				break OuterLoop
+			}
+			c.mu.Unlock()
+		}
+
+		for _, sub := range c.subscribers {
+			close(sub)
+		}
+		c.subscribers = nil
+		c.done = true
+		c.mu.Unlock()
+	}()
+}
+
+// store caches an item, returning the error to emit in its place should it
+// exceed the Budget; c.mu must be held.
+func (c *ConnectableObservable) store(item interface{}) error {
+	if !c.budget.Acquire() {
+		return c.budget.Shed()
+	}
+	c.cache = append(c.cache, item)
+	if c.size > 0 && len(c.cache) > c.size {
+		c.cache = c.cache[1:]
+		c.budget.Release(1)
+	}
+	return nil
+}
+
+// disconnect stops reading the source, leaving the unread items in it;
+// c.mu must be held.
+func (c *ConnectableObservable) disconnect() {
+	if c.quit != nil {
+		close(c.quit)
+		c.quit = nil
+	}
+}
+
+// RefCountObservable connects a ConnectableObservable as soon as it has a
+// subscriber and disconnects it once the last subscriber is disposed.
+type RefCountObservable struct {
+	co    *ConnectableObservable
+	count int
+}
+
+// RefCount returns a RefCountObservable managing the connection of the
+// ConnectableObservable.
+func (c *ConnectableObservable) RefCount() *RefCountObservable {
+	return &RefCountObservable{co: c}
+}
+
+// Share returns a RefCountObservable sharing an Observable between its
+// subscribers, which is read only while there is at least one of them.
+func Share(o observable.Observable) *RefCountObservable {
+	return Publish(o).RefCount()
+}
+
+// Observable returns a new Observable receiving the items of the source from
+// now on, connecting the source if it is the first subscriber, and a dispose
+// func. Calling dispose completes the new Observable and disconnects the
+// source if it was the last subscriber.
+func (r *RefCountObservable) Observable() (observable.Observable, func()) {
+	c := r.co
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	in, out := c.add()
+	if in == nil {
+		return out, func() {}
+	}
+	r.count++
+	c.connect()
+
+	var once sync.Once
+	dispose := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.remove(in)
+			r.count--
+			if r.count == 0 {
+				c.disconnect()
+			}
+		})
+	}
+	return out, dispose
+}
+
+// Subscribe subscribes an EventHandler like Observable and returns a
+// Subscription channel along with the dispose func.
+func (r *RefCountObservable) Subscribe(handler rx.EventHandler) (<-chan subscription.Subscription, func()) {
+	o, dispose := r.Observable()
+	return o.Subscribe(handler), dispose
+}
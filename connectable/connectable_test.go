@@ -9,6 +9,7 @@ import (
 	"github.com/reactivex/rxgo/fx"
 	"github.com/reactivex/rxgo/handlers"
 	"github.com/reactivex/rxgo/iterable"
+	"github.com/reactivex/rxgo/observable"
 	"github.com/reactivex/rxgo/observer"
 
 	"github.com/stretchr/testify/assert"
@@ -524,3 +525,139 @@ func TestConnectableDistinctUntilChanged(t *testing.T) {
 
 	assert.Exactly(t, []int{1, 2, 1, 3}, nums)
 }
+
+func drain(o observable.Observable) []interface{} {
+	items := []interface{}{}
+	for item := range o {
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestPublish(t *testing.T) {
+	calls := 0
+	source := observable.Start(func() interface{} {
+		calls++
+		return "response"
+	})
+
+	co := Publish(source)
+	first := co.Observable()
+	second := co.Observable()
+
+	items := []interface{}{}
+	sub := co.Subscribe(handlers.NextFunc(func(item interface{}) {
+		items = append(items, item)
+	}))
+
+	co.Connect()
+	co.Connect()
+	<-sub
+
+	assert.Exactly(t, []interface{}{"response"}, drain(first))
+	assert.Exactly(t, []interface{}{"response"}, drain(second))
+	assert.Exactly(t, []interface{}{"response"}, items)
+	assert.Equal(t, 1, calls)
+	assert.Exactly(t, []interface{}{}, drain(co.Observable()))
+}
+
+func TestPublishWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	co := Publish(observable.Just(1, myerr, 2))
+	first := co.Observable()
+	co.Connect()
+
+	assert.Exactly(t, []interface{}{1, myerr}, drain(first))
+	assert.Exactly(t, []interface{}{myerr}, drain(co.Observable()))
+}
+
+func TestConnectDispose(t *testing.T) {
+	source := make(chan interface{})
+	co := Publish(observable.Observable(source))
+	first := co.Observable()
+
+	sub := co.Connect()
+	source <- 1
+	assert.Equal(t, 1, <-first)
+
+	// The source is no longer read once the connection is disposed of.
+	sub.Dispose()
+	select {
+	case source <- 2:
+		assert.Fail(t, "source read after the connection is disposed of")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// Connecting again resumes reading it.
+	assert.False(t, co.Connect().IsDisposed())
+	source <- 2
+	assert.Equal(t, 2, <-first)
+	close(source)
+	assert.Exactly(t, []interface{}{}, drain(first))
+}
+
+func TestRefCount(t *testing.T) {
+	source := make(chan interface{})
+	shared := Share(observable.Observable(source))
+
+	first, disposeFirst := shared.Observable()
+	second, disposeSecond := shared.Observable()
+	source <- 1
+	assert.Equal(t, 1, <-first)
+	assert.Equal(t, 1, <-second)
+
+	disposeFirst()
+	disposeFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+
+	source <- 2
+	assert.Equal(t, 2, <-second)
+
+	// The source is no longer read without any subscriber.
+	disposeSecond()
+	select {
+	case source <- 3:
+		assert.Fail(t, "source read without any subscriber")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	third, _ := shared.Observable()
+	source <- 3
+	close(source)
+	assert.Exactly(t, []interface{}{3}, drain(third))
+
+	fourth, _ := shared.Observable()
+	assert.Exactly(t, []interface{}{}, drain(fourth))
+}
+
+func TestReplay(t *testing.T) {
+	calls := 0
+	pages := observable.Start(func() interface{} {
+		calls++
+		return "page 1"
+	})
+
+	co := Replay(observable.Concat(pages, observable.Just("page 2", "page 3")), 2)
+	first := co.Observable()
+	co.Connect()
+
+	assert.Exactly(t, []interface{}{"page 1", "page 2", "page 3"}, drain(first))
+	assert.Exactly(t, []interface{}{"page 2", "page 3"}, drain(co.Observable()))
+	assert.Equal(t, 1, calls)
+}
+
+func TestReplayWithBudget(t *testing.T) {
+	budget := observable.NewBudget(2, observable.ShedError)
+	observable.SetBudget(budget)
+	defer observable.SetBudget(nil)
+
+	co := Replay(observable.Just(1, 2, 3, 4), 0)
+	co.Connect()
+
+	items := drain(co.Observable())
+	assert.Len(t, items, 3)
+	assert.Exactly(t, []interface{}{1, 2}, items[:2])
+	assert.Error(t, items[2].(error))
+	assert.Equal(t, 2, budget.Used())
+}
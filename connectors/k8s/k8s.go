@@ -0,0 +1,216 @@
+// Package k8s exposes Kubernetes watch streams of objects such as pods,
+// configmaps and custom resources as Observables of typed Events.
+package k8s
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+)
+
+// EventType is the type of a change to a Kubernetes object.
+type EventType string
+
+const (
+	// Added is emitted for new objects and for objects of the initial list.
+	Added EventType = "ADDED"
+	// Modified is emitted for updated objects.
+	Modified EventType = "MODIFIED"
+	// Deleted is emitted for deleted objects.
+	Deleted EventType = "DELETED"
+	// Bookmark only advances the resource version and is never emitted.
+	Bookmark EventType = "BOOKMARK"
+	// Error carries an error as its Object.
+	Error EventType = "ERROR"
+	// Synced is emitted for every object listed again after the watched
+	// resource version has expired.
+	Synced EventType = "SYNCED"
+)
+
+// ErrExpired should be returned by Watch, or sent as the Object of an Error
+// Event, when the API server reports the resource version as too old
+// (410 Gone), so that the objects get listed again. It may be wrapped.
+var ErrExpired = rxerrors.New(rxerrors.ExpiredError, "resource version expired")
+
+// Event is a change to a Kubernetes object. Object holds the typed object,
+// such as a *v1.Pod, as returned by the ListerWatcher.
+type Event struct {
+	Type            EventType
+	Object          interface{}
+	ResourceVersion string
+}
+
+// Watcher is a running watch, like client-go's watch.Interface.
+type Watcher interface {
+	ResultChan() <-chan Event
+	Stop()
+}
+
+// ListerWatcher lists and watches one kind of Kubernetes objects. It is
+// usually a thin adapter around a client-go ListWatch.
+type ListerWatcher interface {
+	// List returns the current objects along with their resource versions
+	// and the resource version of the list itself.
+	List() ([]Event, string, error)
+
+	// Watch starts watching the changes made after a resource version.
+	Watch(resourceVersion string) (Watcher, error)
+
+	// Key identifies an object across lists and watches, usually by its
+	// namespace and name as client-go's MetaNamespaceKeyFunc does.
+	Key(obj interface{}) string
+}
+
+// watchBackoff delays restarting a watch which ended without any Event, so
+// that an API server closing watches at once is not hammered.
+var watchBackoff observable.BackoffPolicy = observable.ExponentialBackoff{
+	Initial: time.Second,
+	Max:     time.Minute,
+	Jitter:  0.1,
+}
+
+// Watch creates an Observable emitting an Added Event for every listed object,
+// followed by the Events of a watch started from the list's resource version.
+// The watch is restarted from the last seen resource version whenever it ends,
+// after a backoff if it ended without any Event. The objects are listed again
+// as Synced Events whenever that version has expired, and a Deleted Event
+// carrying the last known object is emitted for each object gone meanwhile.
+// Any other error is emitted and terminates the Observable, as does closing
+// term or disposing of a Subscription to the Observable.
+func Watch(lw ListerWatcher, term <-chan struct{}) observable.Observable {
+	source := make(chan interface{})
+	quit := observable.Stoppable(source)
+	clock := observable.CurrentClock()
+	go func() {
+		defer observable.CloseOut(source)
+
+		emit := func(item interface{}) bool {
+			select {
+			case source <- item:
+				return true
+			case <-term:
+			case <-quit:
+			}
+			return false
+		}
+
+		// known holds the last Event of every existing object, by key.
+		known := make(map[string]Event)
+		emitEvent := func(ev Event) bool {
+			if ev.Type == Deleted {
+				delete(known, lw.Key(ev.Object))
+			} else {
+				known[lw.Key(ev.Object)] = ev
+			}
+			return emit(ev)
+		}
+
+		list := func(typ EventType) (string, bool) {
+			objects, resourceVersion, err := lw.List()
+			if err != nil {
+				emit(err)
+				return "", false
+			}
+			previous := known
+			known = make(map[string]Event, len(objects))
+			for _, obj := range objects {
+				obj.Type = typ
+				delete(previous, lw.Key(obj.Object))
+				if !emitEvent(obj) {
+					return "", false
+				}
+			}
+
+			keys := make([]string, 0, len(previous))
+			for key := range previous {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				ev := Event{Type: Deleted, Object: previous[key].Object, ResourceVersion: resourceVersion}
+				if !emit(ev) {
+					return "", false
+				}
+			}
+			return resourceVersion, true
+		}
+
+		attempt := 0
+		resourceVersion, ok := list(Added)
+		for ok {
+			w, err := lw.Watch(resourceVersion)
+			if errors.Is(err, ErrExpired) {
+				resourceVersion, ok = list(Synced)
+				continue
+			}
+			if err != nil {
+				emit(err)
+				return
+			}
+
+			var next string
+			next, ok, err = follow(w, resourceVersion, emitEvent, term, quit)
+			w.Stop()
+			if errors.Is(err, ErrExpired) {
+				attempt = 0
+				resourceVersion, ok = list(Synced)
+				continue
+			} else if err != nil {
+				emit(err)
+				return
+			}
+
+			if next != resourceVersion {
+				attempt = 0
+				resourceVersion = next
+				continue
+			}
+			attempt++
+			delay, _ := watchBackoff.Backoff(attempt)
+			select {
+			case <-term:
+				return
+			case <-quit:
+				return
+			case <-clock.After(delay):
+			}
+		}
+	}()
+	return observable.Observable(source)
+}
+
+// follow emits the Events of a watch until it ends, and returns the last seen
+// resource version, whether to go on watching, and the error which ended it.
+func follow(w Watcher, resourceVersion string, emit func(Event) bool, term, quit <-chan struct{}) (string, bool, error) {
+	events := w.ResultChan()
+	for {
+		select {
+		case <-term:
+			return resourceVersion, false, nil
+		case <-quit:
+			return resourceVersion, false, nil
+		case ev, ok := <-events:
+			if !ok {
+				return resourceVersion, true, nil
+			}
+
+			switch ev.Type {
+			case Error:
+				if err, isErr := ev.Object.(error); isErr {
+					return resourceVersion, true, err
+				}
+				return resourceVersion, true, rxerrors.New(rxerrors.ObservableError, "watch failed")
+			case Bookmark:
+				resourceVersion = ev.ResourceVersion
+			default:
+				resourceVersion = ev.ResourceVersion
+				if !emit(ev) {
+					return resourceVersion, false, nil
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/observer"
+	"github.com/reactivex/rxgo/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWatcher struct {
+	events  chan Event
+	stopped bool
+}
+
+func (w *fakeWatcher) ResultChan() <-chan Event {
+	return w.events
+}
+
+func (w *fakeWatcher) Stop() {
+	w.stopped = true
+}
+
+type fakeListerWatcher struct {
+	lists   [][]Event
+	watches [][]Event
+	from    []string
+}
+
+func (lw *fakeListerWatcher) List() ([]Event, string, error) {
+	if len(lw.lists) == 0 {
+		return nil, "", errors.New("list failed")
+	}
+	objects := lw.lists[0]
+	lw.lists = lw.lists[1:]
+	return objects, objects[len(objects)-1].ResourceVersion, nil
+}
+
+func (lw *fakeListerWatcher) Watch(resourceVersion string) (Watcher, error) {
+	lw.from = append(lw.from, resourceVersion)
+	if len(lw.watches) == 0 {
+		return nil, errors.New("watch failed")
+	}
+	w := &fakeWatcher{events: make(chan Event, len(lw.watches[0]))}
+	for _, ev := range lw.watches[0] {
+		w.events <- ev
+	}
+	close(w.events)
+	lw.watches = lw.watches[1:]
+	return w, nil
+}
+
+func (lw *fakeListerWatcher) Key(obj interface{}) string {
+	return obj.(string)
+}
+
+func TestWatch(t *testing.T) {
+	lw := &fakeListerWatcher{
+		lists: [][]Event{
+			{{Object: "pod-a", ResourceVersion: "1"}, {Object: "pod-b", ResourceVersion: "2"}},
+			{{Object: "pod-b", ResourceVersion: "6"}},
+		},
+		watches: [][]Event{
+			{{Type: Modified, Object: "pod-a", ResourceVersion: "3"}},
+			{{Type: Bookmark, ResourceVersion: "4"}, {Type: Deleted, Object: "pod-a", ResourceVersion: "5"}},
+			{{Type: Error, Object: ErrExpired}},
+		},
+	}
+
+	events := []Event{}
+	var myerr error
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		events = append(events, item.(Event))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Watch(lw, nil).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []Event{
+		{Type: Added, Object: "pod-a", ResourceVersion: "1"},
+		{Type: Added, Object: "pod-b", ResourceVersion: "2"},
+		{Type: Modified, Object: "pod-a", ResourceVersion: "3"},
+		{Type: Deleted, Object: "pod-a", ResourceVersion: "5"},
+		{Type: Synced, Object: "pod-b", ResourceVersion: "6"},
+	}, events)
+	assert.Exactly(t, []string{"2", "3", "5", "6"}, lw.from)
+	assert.EqualError(t, myerr, "watch failed")
+}
+
+func TestWatchRelistDeletesVanished(t *testing.T) {
+	lw := &fakeListerWatcher{
+		lists: [][]Event{
+			{{Object: "pod-a", ResourceVersion: "1"}, {Object: "pod-b", ResourceVersion: "2"}},
+			{{Object: "pod-b", ResourceVersion: "6"}},
+		},
+		watches: [][]Event{
+			{{Type: Error, Object: ErrExpired}},
+		},
+	}
+
+	items := []interface{}{}
+	for item := range Watch(lw, nil) {
+		items = append(items, item)
+	}
+
+	if assert.Len(t, items, 5) {
+		assert.Exactly(t, []interface{}{
+			Event{Type: Added, Object: "pod-a", ResourceVersion: "1"},
+			Event{Type: Added, Object: "pod-b", ResourceVersion: "2"},
+			Event{Type: Synced, Object: "pod-b", ResourceVersion: "6"},
+			Event{Type: Deleted, Object: "pod-a", ResourceVersion: "6"},
+		}, items[:4])
+		assert.EqualError(t, items[4].(error), "watch failed")
+	}
+}
+
+func TestWatchExpiredWrapped(t *testing.T) {
+	lw := &fakeListerWatcher{
+		lists: [][]Event{
+			{{Object: "pod-a", ResourceVersion: "1"}},
+			{{Object: "pod-a", ResourceVersion: "2"}},
+		},
+		watches: [][]Event{
+			{{Type: Error, Object: fmt.Errorf("410 gone: %w", ErrExpired)}},
+			{{Type: Error}},
+		},
+	}
+
+	items := []interface{}{}
+	for item := range Watch(lw, nil) {
+		items = append(items, item)
+	}
+
+	// Another ObservableError does not get the objects listed again.
+	if assert.Len(t, items, 3) {
+		assert.Equal(t, Event{Type: Synced, Object: "pod-a", ResourceVersion: "2"}, items[1])
+		assert.EqualError(t, items[2].(error), "3 - watch failed")
+	}
+}
+
+func TestWatchBackoff(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	observable.SetClock(s)
+	defer observable.SetClock(nil)
+
+	lw := &fakeListerWatcher{
+		lists: [][]Event{
+			{{Object: "pod-a", ResourceVersion: "1"}},
+		},
+		watches: [][]Event{
+			{},
+			{{Type: Modified, Object: "pod-a", ResourceVersion: "2"}},
+		},
+	}
+
+	source := Watch(lw, nil)
+	assert.Equal(t, Added, (<-source).(Event).Type)
+
+	// The first watch closes at once, and is restarted after a backoff.
+	s.BlockUntil(1)
+	assert.Exactly(t, []string{"1"}, lw.from)
+	s.AdvanceBy(time.Second)
+
+	assert.Equal(t, Modified, (<-source).(Event).Type)
+	_, isErr := (<-source).(error)
+	assert.True(t, isErr)
+	_, ok := <-source
+	assert.False(t, ok)
+	assert.Exactly(t, []string{"1", "1", "2"}, lw.from)
+}
+
+func TestWatchTerminated(t *testing.T) {
+	lw := &fakeListerWatcher{
+		lists: [][]Event{
+			{{Object: "pod-a", ResourceVersion: "1"}, {Object: "pod-b", ResourceVersion: "2"}},
+		},
+	}
+
+	term := make(chan struct{})
+	source := Watch(lw, term)
+
+	ev := <-source
+	assert.Equal(t, "pod-a", ev.(Event).Object)
+
+	// Items already in flight may still be delivered, but the Observable
+	// must complete.
+	close(term)
+	for range source {
+	}
+}
+
+// openListerWatcher lists one object and starts watches which never end.
+type openListerWatcher struct{}
+
+func (openListerWatcher) List() ([]Event, string, error) {
+	return []Event{{Object: "pod-a", ResourceVersion: "1"}}, "1", nil
+}
+
+func (openListerWatcher) Watch(resourceVersion string) (Watcher, error) {
+	return &fakeWatcher{events: make(chan Event)}, nil
+}
+
+func (openListerWatcher) Key(obj interface{}) string {
+	return obj.(string)
+}
+
+func TestWatchDisposed(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	received := make(chan struct{}, 1)
+	onNext := handlers.NextFunc(func(interface{}) {
+		received <- struct{}{}
+	})
+	sub, subs := Watch(openListerWatcher{}, nil).SubscribeDisposable(onNext)
+	<-received
+	sub.Dispose()
+	<-subs
+
+	deadline := time.After(time.Second)
+	for runtime.NumGoroutine() > before {
+		select {
+		case <-deadline:
+			assert.Fail(t, "goroutines left after Dispose")
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
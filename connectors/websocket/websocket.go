@@ -0,0 +1,256 @@
+// Package websocket exposes a WebSocket connection as an Observable of inbound
+// Messages and an Observer of outbound ones, reconnecting as a BackoffPolicy
+// tells it to.
+package websocket
+
+import (
+	"sync"
+	"time"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/observer"
+)
+
+// The message types of RFC 6455, as used by Conn.
+const (
+	TextMessage   = 1
+	BinaryMessage = 2
+)
+
+// Conn is a WebSocket connection. A *websocket.Conn of gorilla/websocket
+// satisfies it as is.
+type Conn interface {
+	ReadMessage() (messageType int, data []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	Close() error
+}
+
+// Dialer opens a connection, such as a closure over the Dial method of a
+// gorilla/websocket Dialer.
+type Dialer func() (Conn, error)
+
+// Message is a message read from or written to a Conn.
+type Message struct {
+	Type int
+	Data []byte
+}
+
+// Options configures a Socket.
+type Options struct {
+	// Policy tells how long to wait before dialing again once the
+	// connection is lost, or failed to open. The attempt count is reset as
+	// soon as a Message is read. A nil Policy never reconnects.
+	Policy observable.BackoffPolicy
+
+	// OnConnect, if set, is called with each new connection before any
+	// Message is read from it, such as to authenticate or subscribe to
+	// channels again.
+	OnConnect func(Conn)
+}
+
+// Socket keeps a WebSocket connection open until it is closed.
+type Socket struct {
+	dial    Dialer
+	opts    Options
+	term    chan struct{}
+	inbound observable.Observable
+	states  observable.Observable
+
+	mu     sync.Mutex
+	conn   Conn
+	closed bool
+
+	// wmu serializes the writes, which a Conn may not support concurrently.
+	wmu sync.Mutex
+}
+
+// Open creates a Socket dialing right away.
+func Open(dial Dialer, opts Options) *Socket {
+	out := make(chan interface{})
+	states, stateStream := observable.Unbounded()
+	s := &Socket{
+		dial:    dial,
+		opts:    opts,
+		term:    make(chan struct{}),
+		inbound: observable.Observable(out),
+		states:  stateStream,
+	}
+	// Disposing of a Subscription to the Messages closes the Socket.
+	observable.OnStop(out, func() {
+		s.Close()
+	})
+	go s.run(out, states)
+	return s
+}
+
+// Observable returns the Observable of the Messages read from the successive
+// connections. Once the Policy gives up, the last error, if any, is emitted
+// and the Observable completes. It completes as well once the Socket is
+// closed.
+func (s *Socket) Observable() observable.Observable {
+	return s.inbound
+}
+
+// States returns an Observable of the ConnectionState transitions, buffered
+// so that a slow consumer never stalls the Messages.
+func (s *Socket) States() observable.Observable {
+	return s.states
+}
+
+// Observer returns an Observer writing each item to the current connection:
+// a Message as is, a []byte as a binary message and a string as a text
+// message. A failed write closes the connection, which is then dialed again.
+// The Socket is closed once the Observer receives an error or completes.
+func (s *Socket) Observer() observer.Observer {
+	return observer.Observer{
+		NextHandler: func(item interface{}) {
+			var msg Message
+			switch item := item.(type) {
+			case Message:
+				msg = item
+			case []byte:
+				msg = Message{BinaryMessage, item}
+			case string:
+				msg = Message{TextMessage, []byte(item)}
+			default:
+				return
+			}
+			s.Send(msg)
+		},
+		ErrHandler: func(err error) {
+			s.Close()
+		},
+		DoneHandler: func() {
+			s.Close()
+		},
+	}
+}
+
+// Send writes a Message to the current connection. A failed write closes the
+// connection, which is then dialed again.
+func (s *Socket) Send(msg Message) error {
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	if conn == nil {
+		return errors.New(errors.ObservableError, "websocket is not connected")
+	}
+
+	s.wmu.Lock()
+	err := conn.WriteMessage(msg.Type, msg.Data)
+	s.wmu.Unlock()
+	if err != nil {
+		conn.Close()
+	}
+	return err
+}
+
+// Close closes the current connection and stops dialing again.
+func (s *Socket) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.term)
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
+
+// run dials and reads the successive connections until the Policy gives up
+// or the Socket is closed.
+func (s *Socket) run(out chan interface{}, states chan<- interface{}) {
+	defer observable.CloseOut(out)
+	defer close(states)
+
+	attempt := 0
+	for {
+		states <- observable.Connecting
+		conn, err := s.dial()
+		if err == nil {
+			if !s.attach(conn) {
+				conn.Close()
+				return
+			}
+			states <- observable.Connected
+			if s.opts.OnConnect != nil {
+				s.opts.OnConnect(conn)
+			}
+
+			var read bool
+			read, err = s.read(conn, out)
+			if read {
+				attempt = 0
+			}
+			s.detach(conn)
+			states <- observable.Disconnected
+		}
+
+		select {
+		case <-s.term:
+			return
+		default:
+		}
+
+		attempt++
+		var delay time.Duration
+		retry := false
+		if s.opts.Policy != nil {
+			delay, retry = s.opts.Policy.Backoff(attempt)
+		}
+		if !retry {
+			select {
+			case out <- err:
+			case <-s.term:
+			}
+			return
+		}
+
+		select {
+		case <-s.term:
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// read emits the Messages read from a connection until it fails, and
+// reports whether any Message has been read along with the error.
+func (s *Socket) read(conn Conn, out chan<- interface{}) (bool, error) {
+	read := false
+	for {
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			return read, err
+		}
+		read = true
+		select {
+		case out <- Message{typ, data}:
+		case <-s.term:
+			return read, nil
+		}
+	}
+}
+
+// attach makes conn the current connection, unless the Socket is closed.
+func (s *Socket) attach(conn Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conn = conn
+	return true
+}
+
+func (s *Socket) detach(conn Conn) {
+	s.mu.Lock()
+	if s.conn == conn {
+		s.conn = nil
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,134 @@
+package websocket
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/observable"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConn struct {
+	inbound chan Message
+	closed  chan struct{}
+	once    sync.Once
+
+	mu      sync.Mutex
+	written []Message
+}
+
+func newFakeConn(msgs ...Message) *fakeConn {
+	c := &fakeConn{
+		inbound: make(chan Message, len(msgs)),
+		closed:  make(chan struct{}),
+	}
+	for _, msg := range msgs {
+		c.inbound <- msg
+	}
+	return c
+}
+
+func (c *fakeConn) ReadMessage() (int, []byte, error) {
+	select {
+	case msg := <-c.inbound:
+		return msg.Type, msg.Data, nil
+	case <-c.closed:
+		return 0, nil, errors.New("connection closed")
+	}
+}
+
+func (c *fakeConn) WriteMessage(typ int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.written = append(c.written, Message{typ, data})
+	return nil
+}
+
+func (c *fakeConn) Close() error {
+	c.once.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
+
+func TestSocketReconnects(t *testing.T) {
+	first := newFakeConn(Message{TextMessage, []byte("a")})
+	second := newFakeConn(Message{TextMessage, []byte("b")})
+	conns := []*fakeConn{first, second}
+	connected := make(chan Conn, 2)
+
+	s := Open(func() (Conn, error) {
+		conn := conns[0]
+		conns = conns[1:]
+		return conn, nil
+	}, Options{
+		Policy: observable.ConstantBackoff{Delay: time.Millisecond},
+		OnConnect: func(conn Conn) {
+			connected <- conn
+		},
+	})
+	myStream := s.Observable()
+
+	assert.Equal(t, Message{TextMessage, []byte("a")}, <-myStream)
+	assert.Equal(t, first, <-connected)
+	first.Close()
+
+	assert.Equal(t, Message{TextMessage, []byte("b")}, <-myStream)
+	assert.Equal(t, second, <-connected)
+	s.Close()
+
+	_, ok := <-myStream
+	assert.False(t, ok)
+
+	states := []interface{}{}
+	for state := range s.States() {
+		states = append(states, state)
+	}
+	assert.Exactly(t, []interface{}{
+		observable.Connecting, observable.Connected, observable.Disconnected,
+		observable.Connecting, observable.Connected, observable.Disconnected,
+	}, states)
+}
+
+func TestSocketGivesUp(t *testing.T) {
+	myerr := errors.New("refused")
+	s := Open(func() (Conn, error) {
+		return nil, myerr
+	}, Options{Policy: observable.ConstantBackoff{Delay: time.Millisecond, MaxAttempts: 2}})
+
+	items := []interface{}{}
+	for item := range s.Observable() {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{myerr}, items)
+}
+
+func TestSocketObserver(t *testing.T) {
+	conn := newFakeConn()
+	connected := make(chan struct{})
+	s := Open(func() (Conn, error) {
+		return conn, nil
+	}, Options{
+		OnConnect: func(Conn) {
+			close(connected)
+		},
+	})
+	<-connected
+
+	<-observable.Just("hello", []byte{1, 2}, Message{TextMessage, []byte("bye")}, 42).Subscribe(s.Observer())
+
+	conn.mu.Lock()
+	assert.Exactly(t, []Message{
+		{TextMessage, []byte("hello")},
+		{BinaryMessage, []byte{1, 2}},
+		{TextMessage, []byte("bye")},
+	}, conn.written)
+	conn.mu.Unlock()
+
+	// Completing the outbound stream closes the Socket.
+	_, ok := <-s.Observable()
+	assert.False(t, ok)
+	assert.Error(t, s.Send(Message{TextMessage, []byte("late")}))
+}
@@ -4,9 +4,9 @@ package errors
 
 import "fmt"
 
-const _ErrorCode_name = "EndOfIteratorErrorHandlerErrorObservableErrorObserverErrorIterableErrorUndefinedError"
+const _ErrorCode_name = "EndOfIteratorErrorHandlerErrorObservableErrorObserverErrorIterableErrorUndefinedErrorNoSuchElementErrorTimeoutErrorOverflowErrorCompositeErrorExpiredError"
 
-var _ErrorCode_index = [...]uint8{0, 18, 30, 45, 58, 71, 85}
+var _ErrorCode_index = [...]uint8{0, 18, 30, 45, 58, 71, 85, 103, 115, 128, 142, 154}
 
 func (i ErrorCode) String() string {
 	i -= 1
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ErrorType serves as error code for the error enum
 type ErrorCode uint32
@@ -12,12 +15,18 @@ const (
 	ObserverError
 	IterableError
 	UndefinedError
+	NoSuchElementError
+	TimeoutError
+	OverflowError
+	CompositeError
+	ExpiredError
 )
 
 // BaseError provides a base template for more package-specific errors
 type BaseError struct {
 	code    ErrorCode
 	message string
+	cause   error
 }
 
 func New(code ErrorCode, msg ...string) BaseError {
@@ -32,11 +41,67 @@ func New(code ErrorCode, msg ...string) BaseError {
 	return err
 }
 
+// Wrap is like New but records the error which caused this one, so that
+// errors.Is and errors.As look through it.
+func Wrap(code ErrorCode, cause error, msg ...string) BaseError {
+	err := New(code, msg...)
+	err.cause = cause
+	return err
+}
+
+// Composite creates a CompositeError gathering every given error, such as the
+// failures of several sources. errors.Is and errors.As look through each one.
+func Composite(errs ...error) BaseError {
+	msg := fmt.Sprintf("%d errors occurred", len(errs))
+	return Wrap(CompositeError, &causes{errs}, msg)
+}
+
 // Error returns an error string to implement the error interface
 func (err BaseError) Error() string {
+	if err.cause != nil {
+		return fmt.Sprintf("%d - %s: %v", err.code, err.message, err.cause)
+	}
 	return fmt.Sprintf("%d - %s", err.code, err.message)
 }
 
 func (err BaseError) Code() int {
 	return int(err.code)
 }
+
+// Unwrap returns the error which caused this one, if any.
+func (err BaseError) Unwrap() error {
+	return err.cause
+}
+
+// Is reports whether target is a BaseError of the same code, whatever its
+// message, so that errors.Is(err, New(TimeoutError)) matches any timeout.
+func (err BaseError) Is(target error) bool {
+	t, ok := target.(BaseError)
+	return ok && t.code == err.code
+}
+
+// Errors returns the errors gathered by a CompositeError, or nil.
+func (err BaseError) Errors() []error {
+	if c, ok := err.cause.(*causes); ok {
+		return c.errs
+	}
+	return nil
+}
+
+// causes is the cause of a CompositeError. It is held by pointer so that
+// BaseError values remain comparable.
+type causes struct {
+	errs []error
+}
+
+func (c *causes) Error() string {
+	msgs := make([]string, len(c.errs))
+	for i, err := range c.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (c *causes) Unwrap() []error {
+	return c.errs
+}
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"testing"
 
@@ -14,6 +15,11 @@ var errcodes = []ErrorCode{
 	ObserverError,
 	IterableError,
 	UndefinedError,
+	NoSuchElementError,
+	TimeoutError,
+	OverflowError,
+	CompositeError,
+	ExpiredError,
 }
 
 func TestErrorCodes(t *testing.T) {
@@ -58,3 +64,31 @@ func TestCodeMethod(t *testing.T) {
 		assert.EqualValues(t, i+1, err.Code())
 	}
 }
+
+func TestWrap(t *testing.T) {
+	cause := stderrors.New("connection reset")
+	err := Wrap(TimeoutError, cause, "request timed out")
+
+	assert.Equal(t, "8 - request timed out: connection reset", err.Error())
+	assert.Equal(t, cause, err.Unwrap())
+	assert.True(t, stderrors.Is(err, cause))
+	assert.True(t, stderrors.Is(err, New(TimeoutError)))
+	assert.False(t, stderrors.Is(err, New(OverflowError)))
+
+	var target BaseError
+	assert.True(t, stderrors.As(error(err), &target))
+	assert.Equal(t, int(TimeoutError), target.Code())
+}
+
+func TestComposite(t *testing.T) {
+	first := stderrors.New("first")
+	second := New(OverflowError, "buffer overflow")
+	err := Composite(first, second)
+
+	assert.Equal(t, int(CompositeError), err.Code())
+	assert.Exactly(t, []error{first, second}, err.Errors())
+	assert.Equal(t, "10 - 2 errors occurred: first; 9 - buffer overflow", err.Error())
+	assert.True(t, stderrors.Is(err, first))
+	assert.True(t, stderrors.Is(err, New(OverflowError)))
+	assert.Nil(t, New(CompositeError).Errors())
+}
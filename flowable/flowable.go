@@ -0,0 +1,127 @@
+// Package flowable provides Flowable, a stream which only produces as many
+// items as its subscriber has requested, after the Reactive Streams protocol.
+package flowable
+
+import (
+	"sync"
+
+	"github.com/reactivex/rxgo/observable"
+)
+
+// Flowable pulls items from a producer on demand. Unlike an Observable, which
+// pushes items as fast as they come, it leaves the unrequested items in the
+// producer, so that a slow consumer sets the pace.
+type Flowable struct {
+	pull func(quit <-chan struct{}) (interface{}, bool)
+}
+
+// FromObservable creates a Flowable reading the given Observable only as far
+// as items have been requested, which blocks its producer meanwhile.
+func FromObservable(o observable.Observable) Flowable {
+	return Flowable{
+		pull: func(quit <-chan struct{}) (interface{}, bool) {
+			select {
+			case item, ok := <-o:
+				return item, ok
+			case <-quit:
+				return nil, false
+			}
+		},
+	}
+}
+
+// Generate creates a Flowable calling next for each requested item, until
+// next reports there are no more of them.
+func Generate(next func() (interface{}, bool)) Flowable {
+	return Flowable{
+		pull: func(<-chan struct{}) (interface{}, bool) {
+			return next()
+		},
+	}
+}
+
+// Subscription controls the demand of a subscriber to a Flowable.
+type Subscription struct {
+	mu     sync.Mutex
+	demand int64
+	signal chan struct{}
+	quit   chan struct{}
+	once   sync.Once
+}
+
+// Request adds n items to the demand. A non-positive n is ignored.
+func (s *Subscription) Request(n int64) {
+	if n <= 0 {
+		return
+	}
+	s.mu.Lock()
+	s.demand += n
+	s.mu.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Cancel stops the Flowable, which completes the subscriber. It may be called
+// many times.
+func (s *Subscription) Cancel() {
+	s.once.Do(func() {
+		close(s.quit)
+	})
+}
+
+// take consumes one item of demand, waiting for a Request if there is none,
+// and reports false once cancelled.
+func (s *Subscription) take() bool {
+	for {
+		s.mu.Lock()
+		if s.demand > 0 {
+			s.demand--
+			s.mu.Unlock()
+			return true
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.signal:
+		case <-s.quit:
+			return false
+		}
+	}
+}
+
+// Subscribe returns an Observable receiving the items of the Flowable as they
+// are requested through the returned Subscription. Nothing is produced before
+// the first Request. An error is delivered like an item and terminates the
+// Observable.
+func (f Flowable) Subscribe() (observable.Observable, *Subscription) {
+	out := make(chan interface{})
+	sub := &Subscription{
+		signal: make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+	// Disposing of a Subscription to the Observable cancels the Flowable's.
+	observable.OnStop(out, sub.Cancel)
+
+	go func() {
+	OuterLoop:
+		for sub.take() {
+			item, ok := f.pull(sub.quit)
+			if !ok {
+				break
+			}
+			select {
+			case out <- item:
+			case <-sub.quit:
+				break OuterLoop
+			}
+			if _, isErr := item.(error); isErr {
+				break
+			}
+		}
+		observable.CloseOut(out)
+	}()
+	return observable.Observable(out), sub
+}
@@ -0,0 +1,83 @@
+package flowable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/observable"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateOnDemand(t *testing.T) {
+	produced := 0
+	f := Generate(func() (interface{}, bool) {
+		produced++
+		return produced, produced <= 5
+	})
+
+	items, sub := f.Subscribe()
+	select {
+	case item := <-items:
+		assert.Fail(t, "item produced without demand", item)
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.Equal(t, 0, produced)
+
+	sub.Request(2)
+	assert.Equal(t, 1, <-items)
+	assert.Equal(t, 2, <-items)
+
+	select {
+	case item := <-items:
+		assert.Fail(t, "item produced beyond demand", item)
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.Equal(t, 2, produced)
+
+	sub.Request(10)
+	rest := []interface{}{}
+	for item := range items {
+		rest = append(rest, item)
+	}
+	assert.Exactly(t, []interface{}{3, 4, 5}, rest)
+}
+
+func TestFromObservable(t *testing.T) {
+	source := make(chan interface{})
+	items, sub := FromObservable(observable.Observable(source)).Subscribe()
+
+	// The producer is held back until there is demand.
+	select {
+	case source <- 1:
+		assert.Fail(t, "source read without demand")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	sub.Request(1)
+	source <- 1
+	assert.Equal(t, 1, <-items)
+
+	myerr := errors.New("bang")
+	sub.Request(5)
+	source <- myerr
+	assert.Equal(t, myerr, <-items)
+	_, ok := <-items
+	assert.False(t, ok)
+}
+
+func TestSubscriptionCancel(t *testing.T) {
+	f := Generate(func() (interface{}, bool) {
+		return "item", true
+	})
+
+	items, sub := f.Subscribe()
+	sub.Request(1)
+	assert.Equal(t, "item", <-items)
+
+	sub.Cancel()
+	sub.Cancel()
+	_, ok := <-items
+	assert.False(t, ok)
+}
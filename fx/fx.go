@@ -2,6 +2,8 @@
 // such as Map, Filter, Scan, and Start.
 package fx
 
+import "context"
+
 type (
 
 	// EmittableFunc defines a function that should be used with Start operator.
@@ -11,12 +13,34 @@ type (
 	// MappableFunc defines a function that acts as a predicate to the Map operator.
 	MappableFunc func(interface{}) interface{}
 
-	// ScannableFunc defines a function that acts as a predicate to the Scan operator.
+	// MappableContextFunc defines a function that acts as a predicate to the
+	// MapWithContext operator, receiving the context of the chain.
+	MappableContextFunc func(context.Context, interface{}) interface{}
+
+	// ScannableFunc defines a function that acts as a predicate to the Scan and
+	// Reduce operators.
 	ScannableFunc func(interface{}, interface{}) interface{}
 
+	// CombinableFunc defines a func that combines two items into one, such as
+	// the one passed to the WithLatestFrom operator.
+	CombinableFunc func(interface{}, interface{}) interface{}
+
 	// FilterableFunc defines a func that should be passed to the Filter operator.
 	FilterableFunc func(interface{}) bool
 		
-	// KeySelectorFunc defines a func that should be passed to the Distinct operator.
+	// KeySelectorFunc defines a func that should be passed to the Distinct and
+	// GroupBy operators.
 	KeySelectorFunc func(interface{}) interface{}
+
+	// AggregateFunc defines a func that reduces a group of items to a single item,
+	// such as the ones passed to the Downsample and CombineLatest operators.
+	AggregateFunc func([]interface{}) interface{}
+
+	// SequenceFunc defines a func that extracts a sequence number from an item,
+	// such as the one passed to the DetectGaps operator.
+	SequenceFunc func(interface{}) uint64
+
+	// ComparatorFunc defines a func that tells whether two items are equal,
+	// such as the one passed to the SequenceEqual operator.
+	ComparatorFunc func(interface{}, interface{}) bool
 )
@@ -0,0 +1,23 @@
+package iterable
+
+import "github.com/reactivex/rxgo/errors"
+
+// Generator is an Iterator producing each element on demand, so that it can
+// represent unbounded or expensive sequences such as the lines of a file or
+// the rows of a database cursor.
+type Generator func() (interface{}, bool)
+
+// FromGenerator creates a Generator calling next for each element, until next
+// reports that there is none left.
+func FromGenerator(next func() (interface{}, bool)) Generator {
+	return Generator(next)
+}
+
+// Next returns the next element produced by the Generator and an error when
+// it reaches the end. Next registers Generator to Iterator.
+func (g Generator) Next() (interface{}, error) {
+	if next, ok := g(); ok {
+		return next, nil
+	}
+	return nil, errors.New(errors.EndOfIteratorError)
+}
@@ -0,0 +1,31 @@
+package iterable
+
+import (
+	"testing"
+
+	"github.com/reactivex/rxgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorImplementsIterator(t *testing.T) {
+	assert.Implements(t, (*rx.Iterator)(nil), Generator(nil))
+}
+
+func TestFromGenerator(t *testing.T) {
+	calls := 0
+	gen := FromGenerator(func() (interface{}, bool) {
+		calls++
+		return calls, calls <= 3
+	})
+	assert.Equal(t, 0, calls)
+
+	for i := 1; i <= 3; i++ {
+		v, err := gen.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, i, v)
+		assert.Equal(t, i, calls)
+	}
+
+	_, err := gen.Next()
+	assert.Error(t, err)
+}
@@ -1,8 +1,18 @@
 // Package iterable provides an Iterable type that is capable of converting
-// sequences of empty interface such as slice and channel to an Iterator.
+// sequences such as slices, maps, strings and channels to an Iterator.
 package iterable
 
-import "github.com/reactivex/rxgo/errors"
+import (
+	"reflect"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// Pair is an entry of a map iterated over by an Iterable.
+type Pair struct {
+	Key   interface{}
+	Value interface{}
+}
 
 // Iterable converts channel and slice into an Iterator.
 type Iterable <-chan interface{}
@@ -16,7 +26,10 @@ func (it Iterable) Next() (interface{}, error) {
 	return nil, errors.New(errors.EndOfIteratorError)
 }
 
-// New creates a new Iterable from a slice or a channel of empty interface.
+// New creates a new Iterable from a slice or an array of any element type, a
+// map, whose entries are yielded as Pairs in no particular order, a string,
+// whose runes are yielded, or a channel of any element type which can be
+// received from.
 func New(any interface{}) (Iterable, error) {
 	switch any := any.(type) {
 	case []interface{}:
@@ -32,7 +45,53 @@ func New(any interface{}) (Iterable, error) {
 		return Iterable(any), nil
 	case <-chan interface{}:
 		return Iterable(any), nil
-	default:
-		return nil, errors.New(errors.IterableError)
+	case string:
+		c := make(chan interface{})
+		go func() {
+			for _, r := range any {
+				c <- r
+			}
+			close(c)
+		}()
+		return Iterable(c), nil
+	}
+
+	v := reflect.ValueOf(any)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		c := make(chan interface{}, v.Len())
+		go func() {
+			for i := 0; i < v.Len(); i++ {
+				c <- v.Index(i).Interface()
+			}
+			close(c)
+		}()
+		return Iterable(c), nil
+	case reflect.Map:
+		c := make(chan interface{}, v.Len())
+		go func() {
+			for _, key := range v.MapKeys() {
+				c <- Pair{key.Interface(), v.MapIndex(key).Interface()}
+			}
+			close(c)
+		}()
+		return Iterable(c), nil
+	case reflect.Chan:
+		if v.Type().ChanDir()&reflect.RecvDir == 0 {
+			break
+		}
+		c := make(chan interface{})
+		go func() {
+			for {
+				val, ok := v.Recv()
+				if !ok {
+					break
+				}
+				c <- val.Interface()
+			}
+			close(c)
+		}()
+		return Iterable(c), nil
 	}
+	return nil, errors.New(errors.IterableError)
 }
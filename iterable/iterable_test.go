@@ -97,3 +97,53 @@ func TestCreateHeterogeneousIterable(t *testing.T) {
 		}
 	}
 }
+
+// collect reads every item of an Iterable.
+func collect(it Iterable) []interface{} {
+	items := []interface{}{}
+	for {
+		item, err := it.Next()
+		if err != nil {
+			return items
+		}
+		items = append(items, item)
+	}
+}
+
+func TestCreateIterableFromNativeTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	it, err := New([]int{1, 2, 3})
+	assert.Nil(err)
+	assert.Exactly([]interface{}{1, 2, 3}, collect(it))
+
+	it, err = New([2]string{"foo", "bar"})
+	assert.Nil(err)
+	assert.Exactly([]interface{}{"foo", "bar"}, collect(it))
+
+	it, err = New("héé")
+	assert.Nil(err)
+	assert.Exactly([]interface{}{'h', 'é', 'é'}, collect(it))
+
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	var recv <-chan int = ch
+	it, err = New(recv)
+	assert.Nil(err)
+	assert.Exactly([]interface{}{1, 2}, collect(it))
+
+	it, err = New(map[string]int{"a": 1, "b": 2})
+	assert.Nil(err)
+	assert.ElementsMatch([]interface{}{Pair{"a", 1}, Pair{"b", 2}}, collect(it))
+}
+
+func TestCreateIterableFromUnsupportedTypes(t *testing.T) {
+	_, err := New(42)
+	assert.Error(t, err)
+
+	var send chan<- int = make(chan int)
+	_, err = New(send)
+	assert.Error(t, err)
+}
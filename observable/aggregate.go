@@ -0,0 +1,198 @@
+package observable
+
+import (
+	"github.com/reactivex/rxgo/errors"
+)
+
+// Count returns a new Observable which emits the number of items in the
+// original Observable, as an int, once it completes.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Count() Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		count := 0
+		failed := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				failed = true
+				break
+			}
+			count++
+		}
+		if !failed {
+			out <- count
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Sum returns a new Observable which emits the sum of the numeric items in the
+// original Observable once it completes. The sum is an int64 if every item is
+// an integer, and a float64 otherwise. A non-numeric item emits an error.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Sum() Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var intSum int64
+		var floatSum float64
+		integral := true
+		failed := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				failed = true
+				break
+			}
+			if num, ok := toInt64(item); ok && integral {
+				intSum += num
+				continue
+			}
+			num, ok := toFloat64(item)
+			if !ok {
+				out <- errors.New(errors.ObservableError, "cannot sum non-numeric item")
+				failed = true
+				break
+			}
+			if integral {
+				floatSum = float64(intSum)
+				integral = false
+			}
+			floatSum += num
+		}
+		if !failed {
+			if integral {
+				out <- intSum
+			} else {
+				out <- floatSum
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Average returns a new Observable which emits the float64 mean of the numeric
+// items in the original Observable once it completes. A non-numeric item, or
+// no item at all, emits an error.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Average() Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		sum := 0.0
+		count := 0
+		failed := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				failed = true
+				break
+			}
+			num, ok := toFloat64(item)
+			if !ok {
+				out <- errors.New(errors.ObservableError, "cannot average non-numeric item")
+				failed = true
+				break
+			}
+			sum += num
+			count++
+		}
+		if !failed {
+			if count == 0 {
+				out <- errors.New(errors.NoSuchElementError, "observable is empty")
+			} else {
+				out <- sum / float64(count)
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Min returns a new Observable which emits the smallest of the numeric items
+// in the original Observable, as is, once it completes. A non-numeric item, or
+// no item at all, emits an error.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Min() Observable {
+	return o.extremum(func(num, best float64) bool {
+		return num < best
+	})
+}
+
+// Max returns a new Observable which emits the greatest of the numeric items
+// in the original Observable, as is, once it completes. A non-numeric item, or
+// no item at all, emits an error.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Max() Observable {
+	return o.extremum(func(num, best float64) bool {
+		return num > best
+	})
+}
+
+// extremum emits the item for which better holds against every other item.
+func (o Observable) extremum(better func(num, best float64) bool) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var best interface{}
+		var bestNum float64
+		failed := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				failed = true
+				break
+			}
+			num, ok := toFloat64(item)
+			if !ok {
+				out <- errors.New(errors.ObservableError, "cannot compare non-numeric item")
+				failed = true
+				break
+			}
+			if best == nil || better(num, bestNum) {
+				best, bestNum = item, num
+			}
+		}
+		if !failed {
+			if best == nil {
+				out <- errors.New(errors.NoSuchElementError, "observable is empty")
+			} else {
+				out <- best
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// toInt64 converts any built-in integer item to an int64.
+func toInt64(item interface{}) (int64, bool) {
+	switch item := item.(type) {
+	case int:
+		return int64(item), true
+	case int8:
+		return int64(item), true
+	case int16:
+		return int64(item), true
+	case int32:
+		return int64(item), true
+	case int64:
+		return item, true
+	case uint:
+		return int64(item), true
+	case uint8:
+		return int64(item), true
+	case uint16:
+		return int64(item), true
+	case uint32:
+		return int64(item), true
+	case uint64:
+		return int64(item), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,48 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, 3, <-Just("a", "b", "c").Count())
+	assert.Equal(t, 0, <-Empty().Count())
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, <-Just(1, myerr).Count())
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, int64(6), <-Just(1, int8(2), uint(3)).Sum())
+	assert.Equal(t, 4.5, <-Just(1, 2, 1.5).Sum())
+	assert.Equal(t, int64(0), <-Empty().Sum())
+
+	_, isErr := (<-Just(1, "2").Sum()).(error)
+	assert.True(t, isErr)
+}
+
+func TestAverage(t *testing.T) {
+	assert.Equal(t, 2.5, <-Just(1, 2, 3, 4).Average())
+	assert.Equal(t, 0.5, <-Just(float32(0.25), 0.75).Average())
+
+	_, isErr := (<-Empty().Average()).(error)
+	assert.True(t, isErr)
+	_, isErr = (<-Just(1, "2").Average()).(error)
+	assert.True(t, isErr)
+}
+
+func TestMinMax(t *testing.T) {
+	assert.Equal(t, -1.5, <-Just(3, -1.5, 2).Min())
+	assert.Equal(t, 3, <-Just(3, -1.5, 2).Max())
+
+	_, isErr := (<-Empty().Min()).(error)
+	assert.True(t, isErr)
+	_, isErr = (<-Just(1, true).Max()).(error)
+	assert.True(t, isErr)
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, <-Just(1, myerr).Max())
+}
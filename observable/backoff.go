@@ -0,0 +1,71 @@
+package observable
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffPolicy decides how long to wait before a retry attempt, counted
+// from one, and whether that attempt should be made at all.
+type BackoffPolicy interface {
+	Backoff(attempt int) (time.Duration, bool)
+}
+
+// ConstantBackoff waits the same Delay before each retry attempt, up to
+// MaxAttempts attempts, or indefinitely if MaxAttempts is zero.
+type ConstantBackoff struct {
+	Delay       time.Duration
+	MaxAttempts int
+}
+
+// Backoff registers ConstantBackoff to BackoffPolicy.
+func (b ConstantBackoff) Backoff(attempt int) (time.Duration, bool) {
+	if b.MaxAttempts > 0 && attempt > b.MaxAttempts {
+		return 0, false
+	}
+	return b.Delay, true
+}
+
+// ExponentialBackoff waits Initial before the first retry attempt and
+// Multiplier times longer before each following one, up to Max if set.
+// Multiplier defaults to 2. Jitter, between 0 and 1, randomly shortens each
+// delay by up to that fraction so that many clients retrying at once spread
+// out. Attempts are made up to MaxAttempts, or indefinitely if it is zero.
+type ExponentialBackoff struct {
+	Initial     time.Duration
+	Max         time.Duration
+	Multiplier  float64
+	Jitter      float64
+	MaxAttempts int
+}
+
+// Backoff registers ExponentialBackoff to BackoffPolicy.
+func (b ExponentialBackoff) Backoff(attempt int) (time.Duration, bool) {
+	if b.MaxAttempts > 0 && attempt > b.MaxAttempts {
+		return 0, false
+	}
+
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	// Clamp the delay before converting it, since a late attempt overflows
+	// a time.Duration.
+	max := time.Duration(math.MaxInt64)
+	if b.Max > 0 {
+		max = b.Max
+	}
+	delay := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if delay >= float64(max) {
+		delay = float64(max)
+	}
+	if b.Jitter > 0 {
+		delay -= delay * b.Jitter * rand.Float64()
+	}
+	if delay >= float64(max) {
+		// float64(max) may round above max.
+		return max, true
+	}
+	return time.Duration(delay), true
+}
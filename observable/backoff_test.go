@@ -0,0 +1,66 @@
+package observable
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantBackoff(t *testing.T) {
+	policy := ConstantBackoff{Delay: time.Second, MaxAttempts: 2}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		delay, retry := policy.Backoff(attempt)
+		assert.True(t, retry)
+		assert.Equal(t, time.Second, delay)
+	}
+
+	_, retry := policy.Backoff(3)
+	assert.False(t, retry)
+
+	_, retry = ConstantBackoff{}.Backoff(1000)
+	assert.True(t, retry)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	policy := ExponentialBackoff{
+		Initial:     10 * time.Millisecond,
+		Max:         50 * time.Millisecond,
+		MaxAttempts: 5,
+	}
+
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for i, want := range expected {
+		delay, retry := policy.Backoff(i + 1)
+		assert.True(t, retry)
+		assert.Equal(t, want*time.Millisecond, delay)
+	}
+
+	_, retry := policy.Backoff(6)
+	assert.False(t, retry)
+}
+
+func TestExponentialBackoffOverflow(t *testing.T) {
+	policy := ExponentialBackoff{Initial: time.Second}
+	for _, attempt := range []int{35, 64, 1000, 100000} {
+		delay, retry := policy.Backoff(attempt)
+		assert.True(t, retry)
+		assert.Equal(t, time.Duration(math.MaxInt64), delay)
+	}
+
+	policy = ExponentialBackoff{Initial: time.Second, Max: time.Hour, Jitter: 0.5}
+	delay, _ := policy.Backoff(1000)
+	assert.True(t, delay >= 30*time.Minute && delay <= time.Hour, delay)
+}
+
+func TestExponentialBackoffWithJitter(t *testing.T) {
+	policy := ExponentialBackoff{Initial: time.Second, Multiplier: 3, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		delay, retry := policy.Backoff(2)
+		assert.True(t, retry)
+		assert.True(t, delay > 1500*time.Millisecond && delay <= 3*time.Second, delay)
+	}
+}
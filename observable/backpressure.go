@@ -0,0 +1,264 @@
+package observable
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// OverflowStrategy tells what to do with an item which cannot be buffered
+// because a slow consumer has let the buffer fill up.
+type OverflowStrategy int
+
+const (
+	// OverflowBlock waits for the consumer to make room, slowing the
+	// producer down.
+	OverflowBlock OverflowStrategy = iota
+
+	// OverflowDropNewest drops the item which does not fit.
+	OverflowDropNewest
+
+	// OverflowDropOldest drops the oldest buffered item to make room.
+	OverflowDropOldest
+
+	// OverflowError emits an error once the buffered items have been
+	// consumed, which terminates the stream.
+	OverflowError
+)
+
+// offer sends an item to a buffered channel according to an OverflowStrategy,
+// and reports whether it has been sent. With OverflowError, the caller is left
+// to terminate the channel when it has not.
+func offer(ch chan interface{}, item interface{}, strategy OverflowStrategy) bool {
+	switch strategy {
+	case OverflowDropNewest, OverflowError:
+		select {
+		case ch <- item:
+			return true
+		default:
+			return false
+		}
+	case OverflowDropOldest:
+		select {
+		case ch <- item:
+			return true
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- item:
+			return true
+		default:
+			return false
+		}
+	default:
+		ch <- item
+		return true
+	}
+}
+
+// Backpressure returns a new Observable buffering up to buffer items of the
+// original Observable for a slow consumer, and applying the OverflowStrategy
+// once the buffer is full, so that the producer is neither stalled nor
+// allowed to exhaust the memory.
+func (o Observable) Backpressure(buffer int, strategy OverflowStrategy) Observable {
+	return o.Broadcast(1, buffer, strategy)[0]
+}
+
+// Broadcast fans the original Observable out to n Observables, each with its
+// own buffer of the given size and the OverflowStrategy applied when it is
+// full, so that a slow consumer only affects its own branch. Errors are
+// always delivered to every branch. The original Observable is stopped once
+// every branch has been.
+func (o Observable) Broadcast(n int, buffer int, strategy OverflowStrategy) []Observable {
+	branches := make([]chan interface{}, n)
+	outs := make([]Observable, n)
+	var mu sync.Mutex
+	live := n
+	for i := range branches {
+		branches[i] = make(chan interface{}, buffer)
+		outs[i] = Observable(branches[i])
+
+		var once sync.Once
+		onStop(branches[i], func() {
+			once.Do(func() {
+				mu.Lock()
+				live--
+				last := live == 0
+				mu.Unlock()
+				if last {
+					stopUpstream(o)
+				}
+			})
+		})
+	}
+
+	// terminate delivers the last item of a branch once its consumer has
+	// caught up, without stalling the others.
+	terminate := func(i int, item interface{}) {
+		go func(branch chan interface{}) {
+			branch <- item
+			closeOut(branch)
+		}(branches[i])
+		branches[i] = nil
+	}
+
+	go func() {
+		for item := range o {
+			_, isErr := item.(error)
+			for i, branch := range branches {
+				if branch == nil {
+					continue
+				}
+				if isErr {
+					terminate(i, item)
+					continue
+				}
+				if !offer(branch, item, strategy) && strategy == OverflowError {
+					terminate(i, errors.New(errors.OverflowError, "buffer overflow"))
+				}
+			}
+		}
+		for _, branch := range branches {
+			if branch != nil {
+				closeOut(branch)
+			}
+		}
+	}()
+	return outs
+}
+
+// OnBackpressureBuffer reads the original Observable as fast as it emits and
+// buffers up to capacity items for a slow consumer. Once the buffer overflows,
+// the buffered items are emitted followed by an error, and the source is no
+// longer read. Buffered items are held against the Budget.
+func (o Observable) OnBackpressureBuffer(capacity int) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	depth := queued(out)
+	go func() {
+		budget := CurrentBudget()
+		source := o
+		buf := []interface{}{}
+		held := 0
+		for source != nil || len(buf) > 0 {
+			atomic.StoreInt64(depth, int64(len(buf)))
+			var send chan<- interface{}
+			var next interface{}
+			if len(buf) > 0 {
+				send = out
+				next = buf[0]
+			}
+
+			select {
+			case item, ok := <-source:
+				if !ok {
+					source = nil
+					continue
+				}
+				if _, isErr := item.(error); isErr {
+					buf = append(buf, item)
+					source = nil
+					continue
+				}
+				if held >= capacity {
+					buf = append(buf, errors.New(errors.OverflowError, "buffer overflow"))
+					source = nil
+					continue
+				}
+				if !budget.Acquire() {
+					if err := budget.Shed(); err != nil {
+						buf = append(buf, err)
+						source = nil
+					}
+					continue
+				}
+				buf = append(buf, item)
+				held++
+			case send <- next:
+				buf = buf[1:]
+				// Errors are always last and are not held.
+				if held > 0 {
+					held--
+					budget.Release(1)
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// OnBackpressureDrop reads the original Observable as fast as it emits and
+// drops the items which the consumer is not ready to receive right away,
+// passing them to onDrop if it is not nil. Errors are never dropped.
+func (o Observable) OnBackpressureDrop(onDrop func(interface{})) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
+			select {
+			case out <- item:
+			default:
+				if onDrop != nil {
+					onDrop(item)
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// OnBackpressureLatest reads the original Observable as fast as it emits and
+// only keeps the latest item the consumer has not received yet, dropping the
+// older ones. Errors are emitted after the pending item.
+func (o Observable) OnBackpressureLatest() Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		source := o
+		var latest, failure interface{}
+		pending := false
+		for source != nil || pending || failure != nil {
+			var send chan<- interface{}
+			var next interface{}
+			switch {
+			case pending:
+				send, next = out, latest
+			case failure != nil:
+				send, next = out, failure
+			}
+
+			select {
+			case item, ok := <-source:
+				if !ok {
+					source = nil
+					continue
+				}
+				if _, isErr := item.(error); isErr {
+					failure = item
+					source = nil
+					continue
+				}
+				latest, pending = item, true
+			case send <- next:
+				if pending {
+					pending = false
+				} else {
+					failure = nil
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
@@ -0,0 +1,158 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func drain(o Observable) []interface{} {
+	items := []interface{}{}
+	for item := range o {
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestObservableBroadcast(t *testing.T) {
+	tests := []struct {
+		strategy OverflowStrategy
+		slow     []interface{}
+	}{
+		{OverflowDropNewest, []interface{}{1, 2}},
+		{OverflowDropOldest, []interface{}{4, 5}},
+		{OverflowError, []interface{}{1, 2, "error"}},
+	}
+
+	for _, tt := range tests {
+		source := make(chan interface{})
+		branches := Observable(source).Broadcast(2, 2, tt.strategy)
+
+		// The fast branch keeps up while the slow one is not read at all.
+		fast := []interface{}{}
+		for i := 1; i <= 5; i++ {
+			source <- i
+			fast = append(fast, <-branches[0])
+		}
+		close(source)
+
+		assert.Exactly(t, []interface{}{1, 2, 3, 4, 5}, fast)
+		slow := []interface{}{}
+		for _, item := range drain(branches[1]) {
+			if _, isErr := item.(error); isErr {
+				item = "error"
+			}
+			slow = append(slow, item)
+		}
+		assert.Exactly(t, tt.slow, slow)
+	}
+}
+
+func TestObservableBroadcastBlock(t *testing.T) {
+	branches := Range(1, 6).Broadcast(3, 0, OverflowBlock)
+
+	results := make(chan []interface{})
+	for _, branch := range branches {
+		go func(branch Observable) {
+			results <- drain(branch)
+		}(branch)
+	}
+
+	for range branches {
+		assert.Exactly(t, []interface{}{1, 2, 3, 4, 5}, <-results)
+	}
+}
+
+func TestObservableBroadcastError(t *testing.T) {
+	source := make(chan interface{})
+	branches := Observable(source).Broadcast(2, 1, OverflowBlock)
+
+	// The error reaches the fast branch while the slow one is full.
+	source <- 1
+	assert.Equal(t, 1, <-branches[1])
+	err := errors.New("broken")
+	source <- err
+	close(source)
+
+	assert.Exactly(t, []interface{}{err}, drain(branches[1]))
+	assert.Exactly(t, []interface{}{1, err}, drain(branches[0]))
+}
+
+func TestObservableBackpressure(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).Backpressure(3, OverflowDropOldest)
+
+	// The producer is never stalled by the consumer.
+	for i := 1; i <= 10; i++ {
+		source <- i
+	}
+	close(source)
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Exactly(t, []interface{}{8, 9, 10}, drain(myStream))
+}
+
+func TestOnBackpressureBuffer(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).OnBackpressureBuffer(3)
+
+	for i := 1; i <= 3; i++ {
+		source <- i
+	}
+	close(source)
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(myStream))
+
+	source = make(chan interface{})
+	myStream = Observable(source).OnBackpressureBuffer(2)
+	for i := 1; i <= 3; i++ {
+		source <- i
+	}
+
+	items := drain(myStream)
+	assert.Len(t, items, 3)
+	assert.Exactly(t, []interface{}{1, 2}, items[:2])
+	assert.True(t, errors.Is(items[2].(error), rxerrors.New(rxerrors.OverflowError)))
+}
+
+func TestOnBackpressureDrop(t *testing.T) {
+	source := make(chan interface{})
+	dropped := make(chan interface{}, 10)
+	myStream := Observable(source).OnBackpressureDrop(func(item interface{}) {
+		dropped <- item
+	})
+
+	// Nobody is reading, so every item is dropped.
+	source <- 1
+	source <- 2
+	assert.Equal(t, 1, <-dropped)
+	assert.Equal(t, 2, <-dropped)
+
+	myerr := errors.New("bang")
+	go func() {
+		source <- myerr
+	}()
+	assert.Equal(t, myerr, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestOnBackpressureLatest(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).OnBackpressureLatest()
+
+	for i := 1; i <= 5; i++ {
+		source <- i
+	}
+	assert.Equal(t, 5, <-myStream)
+
+	source <- 6
+	myerr := errors.New("bang")
+	source <- myerr
+	assert.Equal(t, 6, <-myStream)
+	assert.Equal(t, myerr, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
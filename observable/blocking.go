@@ -0,0 +1,73 @@
+package observable
+
+import (
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/fx"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+)
+
+// ToSlice blocks until the Observable completes and returns all of its items,
+// or the items received so far along with the error it emitted.
+func (o Observable) ToSlice() ([]interface{}, error) {
+	items := []interface{}{}
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ToMap blocks until the Observable completes and returns its items keyed by
+// a KeySelectorFunc, a later item replacing an earlier one of the same key,
+// or the items received so far along with the error it emitted.
+func (o Observable) ToMap(apply fx.KeySelectorFunc) (map[interface{}]interface{}, error) {
+	items := make(map[interface{}]interface{})
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			return items, err
+		}
+		items[apply(item)] = item
+	}
+	return items, nil
+}
+
+// BlockingFirst blocks until the Observable emits its first item and returns
+// it, or returns an error if the Observable emits one or completes empty.
+func (o Observable) BlockingFirst() (interface{}, error) {
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			return nil, err
+		}
+		return item, nil
+	}
+	return nil, errors.New(errors.NoSuchElementError, "observable is empty")
+}
+
+// BlockingLast blocks until the Observable completes and returns its last
+// item, or returns an error if the Observable emits one or completes empty.
+func (o Observable) BlockingLast() (interface{}, error) {
+	var last interface{}
+	found := false
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			return nil, err
+		}
+		last = item
+		found = true
+	}
+	if !found {
+		return nil, errors.New(errors.NoSuchElementError, "observable is empty")
+	}
+	return last, nil
+}
+
+// ForEach subscribes the handlers, any of which may be nil, and blocks until
+// the Observable terminates. It returns the error the Observable emitted, if
+// any.
+func (o Observable) ForEach(next handlers.NextFunc, err handlers.ErrFunc, done handlers.DoneFunc) error {
+	sub := <-o.Subscribe(observer.New(next, err, done))
+	return sub.Err()
+}
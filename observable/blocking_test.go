@@ -0,0 +1,76 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSlice(t *testing.T) {
+	items, err := Just(1, 2, 3).ToSlice()
+	assert.Nil(t, err)
+	assert.Exactly(t, []interface{}{1, 2, 3}, items)
+
+	items, err = Just(1, errors.New("bang"), 3).ToSlice()
+	assert.EqualError(t, err, "bang")
+	assert.Exactly(t, []interface{}{1}, items)
+}
+
+func TestToMap(t *testing.T) {
+	length := func(item interface{}) interface{} {
+		return len(item.(string))
+	}
+
+	items, err := Just("a", "bb", "c").ToMap(length)
+	assert.Nil(t, err)
+	assert.Equal(t, map[interface{}]interface{}{1: "c", 2: "bb"}, items)
+
+	_, err = Just("a", errors.New("bang")).ToMap(length)
+	assert.EqualError(t, err, "bang")
+}
+
+func TestBlockingFirst(t *testing.T) {
+	item, err := Just(1, 2).BlockingFirst()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+
+	_, err = Empty().BlockingFirst()
+	assert.Error(t, err)
+
+	_, err = Just(errors.New("bang")).BlockingFirst()
+	assert.EqualError(t, err, "bang")
+}
+
+func TestBlockingLast(t *testing.T) {
+	item, err := Just(1, 2).BlockingLast()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, item)
+
+	_, err = Empty().BlockingLast()
+	assert.Error(t, err)
+
+	_, err = Just(1, errors.New("bang")).BlockingLast()
+	assert.EqualError(t, err, "bang")
+}
+
+func TestForEach(t *testing.T) {
+	sum := 0
+	done := false
+	err := Just(1, 2, 3).ForEach(func(item interface{}) {
+		sum += item.(int)
+	}, nil, func() {
+		done = true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 6, sum)
+	assert.True(t, done)
+
+	myerr := errors.New("bang")
+	var handled error
+	err = Just(1, myerr).ForEach(nil, func(e error) {
+		handled = e
+	}, nil)
+	assert.Equal(t, myerr, err)
+	assert.Equal(t, myerr, handled)
+}
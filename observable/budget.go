@@ -0,0 +1,95 @@
+package observable
+
+import (
+	"sync"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// ShedPolicy tells what a buffering operator does with an item which would
+// exceed the Budget.
+type ShedPolicy int
+
+const (
+	// ShedDrop drops the item.
+	ShedDrop ShedPolicy = iota
+
+	// ShedError emits an error which terminates the stream.
+	ShedError
+)
+
+// Budget bounds the number of items held by all the buffering operators,
+// such as Valve, Reorder and the Buffer operators, so that a single
+// misbehaving stream cannot exhaust the memory of the process.
+type Budget struct {
+	mu     sync.Mutex
+	limit  int
+	used   int
+	policy ShedPolicy
+}
+
+// NewBudget creates a Budget of limit items with a ShedPolicy applied to the
+// items exceeding it.
+func NewBudget(limit int, policy ShedPolicy) *Budget {
+	return &Budget{limit: limit, policy: policy}
+}
+
+// Used returns the number of items currently held against the Budget.
+func (b *Budget) Used() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// Acquire reserves room for an item, which always succeeds on a nil Budget.
+// Buffering operators outside of this package use it to share the Budget.
+func (b *Budget) Acquire() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.used >= b.limit {
+		return false
+	}
+	b.used++
+	return true
+}
+
+// Release gives back the room of n items.
+func (b *Budget) Release(n int) {
+	if b == nil || n == 0 {
+		return
+	}
+	b.mu.Lock()
+	b.used -= n
+	b.mu.Unlock()
+}
+
+// Shed returns the item to emit in place of an item exceeding the Budget, or
+// nil if it should be silently dropped.
+func (b *Budget) Shed() error {
+	if b.policy == ShedError {
+		return errors.New(errors.OverflowError, "buffer budget exceeded")
+	}
+	return nil
+}
+
+var (
+	budgetMu     sync.RWMutex
+	globalBudget *Budget
+)
+
+// SetBudget sets the Budget shared by the buffering operators created
+// afterwards. A nil Budget, the default, leaves them unbounded.
+func SetBudget(b *Budget) {
+	budgetMu.Lock()
+	globalBudget = b
+	budgetMu.Unlock()
+}
+
+func CurrentBudget() *Budget {
+	budgetMu.RLock()
+	defer budgetMu.RUnlock()
+	return globalBudget
+}
@@ -0,0 +1,79 @@
+package observable
+
+import (
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget(t *testing.T) {
+	var unlimited *Budget
+	assert.True(t, unlimited.Acquire())
+	unlimited.Release(1)
+
+	budget := NewBudget(2, ShedDrop)
+	assert.True(t, budget.Acquire())
+	assert.True(t, budget.Acquire())
+	assert.False(t, budget.Acquire())
+	assert.Equal(t, 2, budget.Used())
+	assert.Nil(t, budget.Shed())
+
+	budget.Release(2)
+	assert.Equal(t, 0, budget.Used())
+	assert.Error(t, NewBudget(0, ShedError).Shed())
+}
+
+func TestValveWithBudget(t *testing.T) {
+	budget := NewBudget(2, ShedDrop)
+	SetBudget(budget)
+	defer SetBudget(nil)
+
+	source := make(chan interface{})
+	control := make(chan bool)
+	myStream := Observable(source).Valve(control, 10)
+
+	control <- false
+	for i := 1; i <= 4; i++ {
+		source <- i
+	}
+	assert.Equal(t, 2, budget.Used())
+
+	control <- true
+	close(source)
+
+	nums := []int{}
+	for item := range myStream {
+		nums = append(nums, item.(int))
+	}
+	assert.Exactly(t, []int{1, 2}, nums)
+	assert.Equal(t, 0, budget.Used())
+}
+
+func TestReorderWithBudget(t *testing.T) {
+	budget := NewBudget(2, ShedError)
+	SetBudget(budget)
+	defer SetBudget(nil)
+
+	seq := func(item interface{}) uint64 {
+		return uint64(item.(int))
+	}
+
+	nums := []int{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just(4, 3, 2, 1).Reorder(seq, 5).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []int{3, 4}, nums)
+	assert.Error(t, myerr)
+	assert.Equal(t, 0, budget.Used())
+}
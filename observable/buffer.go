@@ -0,0 +1,109 @@
+package observable
+
+import (
+	"time"
+)
+
+// BufferWithCount gathers the items in the original Observable into
+// []interface{} batches of count items. A partial batch is emitted before the
+// original Observable completes or an error is passed on.
+// Buffered items are held against the Budget.
+func (o Observable) BufferWithCount(count int) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	if count < 1 {
+		count = 1
+	}
+	go func() {
+		budget := CurrentBudget()
+		batch := []interface{}{}
+
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			budget.Release(len(batch))
+			out <- batch
+			batch = []interface{}{}
+		}
+
+	OuterLoop:
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				flush()
+				out <- item
+				break OuterLoop
+			}
+			if !budget.Acquire() {
+				if err := budget.Shed(); err != nil {
+					flush()
+					out <- err
+					break OuterLoop
+				}
+				continue
+			}
+			batch = append(batch, item)
+			if len(batch) >= count {
+				flush()
+			}
+		}
+		flush()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// BufferWithTime gathers the items in the original Observable into
+// []interface{} batches spanning the given duration. Empty batches are not
+// emitted. A partial batch is emitted before the original Observable completes
+// or an error is passed on.
+// Buffered items are held against the Budget.
+func (o Observable) BufferWithTime(timespan time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		budget := CurrentBudget()
+		tick := clock.After(timespan)
+		batch := []interface{}{}
+
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			budget.Release(len(batch))
+			out <- batch
+			batch = []interface{}{}
+		}
+
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					flush()
+					out <- item
+					break OuterLoop
+				}
+				if !budget.Acquire() {
+					if err := budget.Shed(); err != nil {
+						flush()
+						out <- err
+						break OuterLoop
+					}
+					continue
+				}
+				batch = append(batch, item)
+			case <-tick:
+				tick = clock.After(timespan)
+				flush()
+			}
+		}
+		flush()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
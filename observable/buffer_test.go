@@ -0,0 +1,67 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferWithCount(t *testing.T) {
+	batches := []interface{}{}
+	for batch := range Just(1, 2, 3, 4, 5).BufferWithCount(2) {
+		batches = append(batches, batch)
+	}
+
+	assert.Exactly(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3, 4},
+		[]interface{}{5},
+	}, batches)
+}
+
+func TestBufferWithCountWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	items := []interface{}{}
+	for item := range Just(1, 2, 3, myerr, 4).BufferWithCount(2) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{
+		[]interface{}{1, 2},
+		[]interface{}{3},
+		myerr,
+	}, items)
+}
+
+func TestBufferWithCountWithBudget(t *testing.T) {
+	budget := NewBudget(2, ShedError)
+	SetBudget(budget)
+	defer SetBudget(nil)
+
+	items := []interface{}{}
+	for item := range Just(1, 2, 3, 4).BufferWithCount(3) {
+		items = append(items, item)
+	}
+
+	assert.Len(t, items, 2)
+	assert.Exactly(t, []interface{}{1, 2}, items[0])
+	assert.Error(t, items[1].(error))
+	assert.Equal(t, 0, budget.Used())
+}
+
+func TestBufferWithTime(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).BufferWithTime(30 * time.Millisecond)
+
+	source <- 1
+	source <- 2
+	assert.Exactly(t, []interface{}{1, 2}, <-myStream)
+
+	source <- 3
+	close(source)
+	assert.Exactly(t, []interface{}{3}, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
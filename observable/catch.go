@@ -0,0 +1,31 @@
+package observable
+
+// Catch mirrors the original Observable and, should it emit an error, replaces
+// the error with the items of the Observable returned by fn for that error.
+func (o Observable) Catch(fn func(error) Observable) Observable {
+	out := make(chan interface{})
+	r := newRelay(out, o)
+	go func() {
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				if fallback := fn(err); r.follow(fallback) {
+					for item := range fallback {
+						out <- item
+					}
+				}
+				break
+			}
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// OnErrorResumeNext mirrors the original Observable and, should it emit an
+// error, replaces the error with the items of the fallback Observable.
+func (o Observable) OnErrorResumeNext(fallback Observable) Observable {
+	return o.Catch(func(error) Observable {
+		return fallback
+	})
+}
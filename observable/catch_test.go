@@ -0,0 +1,51 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatch(t *testing.T) {
+	myerr := errors.New("bang")
+	var caught error
+	fallback := func(err error) Observable {
+		caught = err
+		return Just("a", "b")
+	}
+
+	items := []interface{}{}
+	for item := range Just(1, 2, myerr, 3).Catch(fallback) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, "a", "b"}, items)
+	assert.Equal(t, myerr, caught)
+}
+
+func TestCatchWithoutError(t *testing.T) {
+	fallback := func(err error) Observable {
+		assert.Fail(t, "fallback called without an error")
+		return Empty()
+	}
+
+	items := []interface{}{}
+	for item := range Just(1, 2).Catch(fallback) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2}, items)
+}
+
+func TestOnErrorResumeNext(t *testing.T) {
+	myerr := errors.New("bang")
+	fallerr := errors.New("fallback failed")
+
+	items := []interface{}{}
+	for item := range Just(1, myerr).OnErrorResumeNext(Just(2, fallerr)) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, fallerr}, items)
+}
@@ -0,0 +1,54 @@
+package observable
+
+import (
+	"reflect"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// ToChannel returns a plain channel with the given buffer size receiving the
+// items of the Observable, errors included, which is closed once the
+// Observable completes, so that it can be consumed with for range.
+func (o Observable) ToChannel(buffer int) <-chan interface{} {
+	out := make(chan interface{}, buffer)
+	link(out, o)
+	go func() {
+		for item := range o {
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return out
+}
+
+// ToTypedChannel blocks while it sends the items of the Observable to ch,
+// which must be a channel of the type of the items, such as a chan string,
+// and closes it once the Observable completes. It returns the error emitted
+// by the Observable, or an error as soon as an item does not fit in ch.
+// In both cases ch is closed as well.
+func (o Observable) ToTypedChannel(ch interface{}) error {
+	value := reflect.ValueOf(ch)
+	if value.Kind() != reflect.Chan || value.Type().ChanDir()&reflect.SendDir == 0 {
+		return errors.New(errors.ObservableError, "destination is not a sendable channel")
+	}
+	elem := value.Type().Elem()
+
+	var failure error
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			failure = err
+			break
+		}
+		v := reflect.ValueOf(item)
+		if !v.IsValid() {
+			v = reflect.Zero(elem)
+		}
+		if !v.Type().AssignableTo(elem) {
+			failure = errors.New(errors.ObservableError, "item does not fit in the channel")
+			break
+		}
+		value.Send(v)
+	}
+	value.Close()
+	return failure
+}
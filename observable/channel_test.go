@@ -0,0 +1,45 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToChannel(t *testing.T) {
+	ch := Just(1, 2, 3).ToChannel(3)
+
+	nums := []int{}
+	for item := range ch {
+		nums = append(nums, item.(int))
+	}
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+}
+
+func TestToTypedChannel(t *testing.T) {
+	ch := make(chan string, 3)
+	err := Just("a", "b").ToTypedChannel(ch)
+	assert.Nil(t, err)
+
+	texts := []string{}
+	for text := range ch {
+		texts = append(texts, text)
+	}
+	assert.Exactly(t, []string{"a", "b"}, texts)
+}
+
+func TestToTypedChannelWithError(t *testing.T) {
+	ch := make(chan int, 3)
+	err := Just(1, "two", 3).ToTypedChannel(ch)
+	assert.Error(t, err)
+	assert.Equal(t, 1, <-ch)
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	ch = make(chan int, 3)
+	err = Just(1, errors.New("bang")).ToTypedChannel(ch)
+	assert.EqualError(t, err, "bang")
+
+	assert.Error(t, Just(1).ToTypedChannel(1))
+}
@@ -0,0 +1,66 @@
+package observable
+
+import (
+	"context"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/fx"
+)
+
+// MapWithContext is like Map but passes a context to the MappableContextFunc,
+// so that it can honour its deadline or read its values, such as trace
+// metadata. Once the context is done, the original Observable is no longer
+// read and the context error is emitted.
+func (o Observable) MapWithContext(ctx context.Context, apply fx.MappableContextFunc) Observable {
+	return o.untilDone(ctx).Map(func(item interface{}) interface{} {
+		return apply(ctx, item)
+	})
+}
+
+// FlatMapWithContext is like FlatMap but passes a context to the function
+// creating the inner Observables. Once the context is done, the original
+// Observable is no longer read and the context error is emitted.
+func (o Observable) FlatMapWithContext(ctx context.Context, apply func(context.Context, interface{}) Observable, maxConcurrency int) Observable {
+	return o.untilDone(ctx).FlatMap(func(item interface{}) Observable {
+		return apply(ctx, item)
+	}, maxConcurrency)
+}
+
+// untilDone mirrors the original Observable until the context is done, and
+// then emits the context error. A context which has passed its deadline emits
+// a TimeoutError wrapping it.
+func (o Observable) untilDone(ctx context.Context) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case <-ctx.Done():
+				out <- contextError(ctx)
+				break OuterLoop
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				select {
+				case out <- item:
+				case <-ctx.Done():
+					out <- contextError(ctx)
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// contextError returns the error of a done context, wrapped in a TimeoutError
+// if its deadline has passed.
+func contextError(ctx context.Context) error {
+	if err := ctx.Err(); err != context.DeadlineExceeded {
+		return err
+	}
+	return errors.Wrap(errors.TimeoutError, ctx.Err(), "deadline exceeded")
+}
@@ -0,0 +1,63 @@
+package observable
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type traceKey struct{}
+
+func TestMapWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+	tag := func(ctx context.Context, item interface{}) interface{} {
+		return ctx.Value(traceKey{}).(string) + ":" + item.(string)
+	}
+
+	items := drain(Just("a", "b").MapWithContext(ctx, tag))
+	assert.Exactly(t, []interface{}{"trace-1:a", "trace-1:b"}, items)
+}
+
+func TestMapWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	identity := func(ctx context.Context, item interface{}) interface{} {
+		return item
+	}
+
+	source := make(chan interface{})
+	myStream := Observable(source).MapWithContext(ctx, identity)
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestMapWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	identity := func(ctx context.Context, item interface{}) interface{} {
+		return item
+	}
+
+	err := (<-Never().MapWithContext(ctx, identity)).(error)
+	assert.True(t, errors.Is(err, rxerrors.New(rxerrors.TimeoutError)))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestFlatMapWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-2")
+	expand := func(ctx context.Context, item interface{}) Observable {
+		return Just(ctx.Value(traceKey{}), item)
+	}
+
+	items := drain(Just(1).FlatMapWithContext(ctx, expand, 1))
+	assert.Exactly(t, []interface{}{"trace-2", 1}, items)
+}
@@ -0,0 +1,172 @@
+package observable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// Cron creates an Observable emitting the time.Time of each fire of a crontab
+// schedule, in the local time zone, until term is closed. The spec has the
+// five usual fields, minute, hour, day of month, month and day of week (0 is
+// Sunday), each of which accepts *, numbers, ranges such as 1-5, lists such
+// as 1,15 and steps such as */10 or 0-30/5. As in cron, a day matches if
+// either of the day fields does when both are restricted. An invalid spec
+// emits an error.
+func Cron(term <-chan struct{}, spec string) Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	clock := CurrentClock()
+	go func() {
+		schedule, err := parseCron(spec)
+		if err != nil {
+			trySend(source, err, quit)
+			closeOut(source)
+			return
+		}
+
+		now := clock.Now()
+	OuterLoop:
+		for {
+			next, ok := schedule.next(now)
+			if !ok {
+				break
+			}
+			select {
+			case <-term:
+				break OuterLoop
+			case <-quit:
+				break OuterLoop
+			case <-clock.After(next.Sub(now)):
+			}
+			select {
+			case source <- next:
+			case <-term:
+				break OuterLoop
+			case <-quit:
+				break OuterLoop
+			}
+			now = next
+		}
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// cronSchedule holds, for each field of a crontab spec, the set of values it
+// matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// anyDay is set when either day field is *, so that both must match.
+	anyDay bool
+}
+
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+func parseCron(spec string) (*cronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, errors.New(errors.ObservableError, "cron spec needs 5 fields")
+	}
+
+	sets := make([]uint64, len(fields))
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i].min, cronFields[i].max)
+		if err != nil {
+			msg := fmt.Sprintf("invalid cron %s %q", cronFields[i].name, field)
+			return nil, errors.New(errors.ObservableError, msg)
+		}
+		sets[i] = set
+	}
+	return &cronSchedule{
+		minute: sets[0],
+		hour:   sets[1],
+		dom:    sets[2],
+		month:  sets[3],
+		dow:    sets[4],
+		anyDay: fields[2] == "*" || fields[4] == "*",
+	}, nil
+}
+
+// parseCronField returns the set of values matched by a field as a bit mask.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step")
+			}
+			step = n
+			part = part[:i]
+		}
+
+		lo, hi := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			n, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = n, n
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("out of range")
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// next returns the first time after t, to the minute, matching the schedule,
+// or false if there is none within five years, such as for February 30th.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (s *cronSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDay {
+		return dom && dow
+	}
+	return dom || dow
+}
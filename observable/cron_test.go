@@ -0,0 +1,69 @@
+package observable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 10, 7, 30, 0, time.UTC)
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.January, 31, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.January, 31, 10, 15, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2024, time.January, 31, 13, 0, 0, 0, time.UTC)},
+		{"30 2 1,15 * *", time.Date(2024, time.February, 1, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		s, err := parseCron(c.spec)
+		if assert.NoError(t, err, c.spec) {
+			next, ok := s.next(start)
+			assert.True(t, ok, c.spec)
+			assert.Equal(t, c.want, next, c.spec)
+		}
+	}
+
+	s, err := parseCron("0 0 30 2 *")
+	assert.NoError(t, err)
+	_, ok := s.next(start)
+	assert.False(t, ok)
+}
+
+func TestCronInvalidSpec(t *testing.T) {
+	for _, spec := range []string{"* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		items := drain(Cron(nil, spec))
+		if assert.Len(t, items, 1, spec) {
+			assert.Error(t, items[0].(error), spec)
+		}
+	}
+}
+
+func TestCronWithTestScheduler(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	term := make(chan struct{})
+	myStream := Cron(term, "*/15 * * * *")
+
+	s.BlockUntil(1)
+	s.AdvanceBy(15 * time.Minute)
+	first := (<-myStream).(time.Time)
+	assert.Equal(t, s.Now(), first)
+
+	s.BlockUntil(1)
+	s.AdvanceBy(15 * time.Minute)
+	assert.Equal(t, first.Add(15*time.Minute), <-myStream)
+
+	close(term)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
@@ -0,0 +1,49 @@
+package observable
+
+import (
+	"time"
+)
+
+// Delay shifts each item in the original Observable, errors included, forward
+// in time by the given duration, preserving the spacing between them. The
+// original Observable is read without waiting for the delayed items to be
+// emitted.
+func (o Observable) Delay(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	queue, stamped := Unbounded()
+	clock := CurrentClock()
+	go func() {
+		for item := range o {
+			queue <- Timestamped{Value: item, Time: clock.Now()}
+		}
+		close(queue)
+	}()
+	go func() {
+		for item := range stamped {
+			ts := item.(Timestamped)
+			if wait := ts.Time.Add(d).Sub(clock.Now()); wait > 0 {
+				<-clock.After(wait)
+			}
+			out <- ts.Value
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// DelaySubscription waits for the given duration before it starts reading the
+// original Observable, which it then mirrors.
+func (o Observable) DelaySubscription(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		<-clock.After(d)
+		for item := range o {
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
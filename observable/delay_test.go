@@ -0,0 +1,60 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelay(t *testing.T) {
+	myerr := errors.New("bang")
+	start := time.Now()
+
+	items := []interface{}{}
+	for item := range Just(1, 2, myerr).Delay(30 * time.Millisecond) {
+		if len(items) == 0 {
+			assert.True(t, time.Since(start) >= 30*time.Millisecond)
+		}
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, myerr}, items)
+}
+
+func TestDelayReadsAhead(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).Delay(time.Hour)
+
+	// The source is consumed even though nothing is emitted yet.
+	for i := 0; i < 10; i++ {
+		source <- i
+	}
+	close(source)
+
+	select {
+	case item := <-myStream:
+		assert.Fail(t, "delay emitted too early", item)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestDelaySubscription(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).DelaySubscription(30 * time.Millisecond)
+
+	select {
+	case source <- 1:
+		assert.Fail(t, "source read before the delay")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	go func() {
+		source <- 1
+		close(source)
+	}()
+	assert.Equal(t, 1, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
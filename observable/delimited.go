@@ -0,0 +1,107 @@
+package observable
+
+import (
+	"encoding/binary"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// Marshaler is implemented by protobuf messages which can encode themselves,
+// such as the ones generated by gogo/protobuf.
+type Marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// Unmarshaler is implemented by protobuf messages which can decode themselves,
+// such as the ones generated by gogo/protobuf.
+type Unmarshaler interface {
+	Unmarshal([]byte) error
+}
+
+// MarshalDelimited encodes each Marshaler item in the original Observable
+// into a varint length-prefixed frame and returns a new Observable of []byte.
+// The frames can be written as-is to a TCP connection or a file.
+func (o Observable) MarshalDelimited() Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+	OuterLoop:
+		for item := range o {
+			switch item := item.(type) {
+			case error:
+				out <- item
+				break OuterLoop
+			case Marshaler:
+				msg, err := item.Marshal()
+				if err != nil {
+					out <- errors.Wrap(errors.ObservableError, err, "cannot marshal item")
+					break OuterLoop
+				}
+				frame := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(msg))
+				n := binary.PutUvarint(frame, uint64(len(msg)))
+				out <- append(frame[:n], msg...)
+			default:
+				out <- errors.New(errors.ObservableError, "item does not implement Marshaler")
+				break OuterLoop
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// UnmarshalDelimited reassembles varint length-prefixed frames from the []byte
+// chunks of the original Observable and emits each frame decoded into a new
+// message created by newMsg. Chunks do not need to be aligned with frames.
+func (o Observable) UnmarshalDelimited(newMsg func() Unmarshaler) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var buf []byte
+		failed := false
+	OuterLoop:
+		for item := range o {
+			switch item := item.(type) {
+			case error:
+				out <- item
+				failed = true
+				break OuterLoop
+			case []byte:
+				buf = append(buf, item...)
+			default:
+				out <- errors.New(errors.ObservableError, "item is not a []byte")
+				failed = true
+				break OuterLoop
+			}
+
+			for {
+				size, n := binary.Uvarint(buf)
+				if n < 0 {
+					out <- errors.New(errors.ObservableError, "frame length overflows uint64")
+					failed = true
+					break OuterLoop
+				}
+				if n == 0 || uint64(len(buf)-n) < size {
+					break
+				}
+
+				msg := newMsg()
+				if err := msg.Unmarshal(buf[n : n+int(size)]); err != nil {
+					out <- errors.Wrap(errors.ObservableError, err, "cannot unmarshal frame")
+					failed = true
+					break OuterLoop
+				}
+				out <- msg
+				buf = buf[n+int(size):]
+			}
+		}
+
+		// Leftover bytes can only be a partial frame once the source is
+		// exhausted without an error.
+		if len(buf) > 0 && !failed {
+			out <- errors.New(errors.ObservableError, "truncated frame at the end of stream")
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
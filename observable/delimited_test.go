@@ -0,0 +1,120 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type textMessage struct {
+	text string
+}
+
+func (m *textMessage) Marshal() ([]byte, error) {
+	if m.text == "" {
+		return nil, errors.New("empty message")
+	}
+	return []byte(m.text), nil
+}
+
+func (m *textMessage) Unmarshal(b []byte) error {
+	m.text = string(b)
+	return nil
+}
+
+func newTextMessage() Unmarshaler {
+	return &textMessage{}
+}
+
+func TestMarshalDelimited(t *testing.T) {
+	frames := [][]byte{}
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		frames = append(frames, item.([]byte))
+	})
+
+	sub := Just(&textMessage{"foo"}, &textMessage{"hello"}).MarshalDelimited().Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, [][]byte{
+		[]byte("\x03foo"),
+		[]byte("\x05hello"),
+	}, frames)
+}
+
+func TestMarshalDelimitedWithError(t *testing.T) {
+	frames := 0
+	var myerr error
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		frames++
+	})
+
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	source := Just(&textMessage{"foo"}, &textMessage{}, &textMessage{"bar"})
+	sub := source.MarshalDelimited().Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Equal(t, 1, frames)
+	if assert.Error(t, myerr) {
+		assert.Contains(t, myerr.Error(), "empty message")
+		assert.True(t, errors.Is(myerr, rxerrors.New(rxerrors.ObservableError)))
+	}
+}
+
+func TestUnmarshalDelimited(t *testing.T) {
+	texts := []string{}
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		texts = append(texts, item.(*textMessage).text)
+	})
+
+	// Chunks are split arbitrarily across frame boundaries.
+	source := Just([]byte("\x03f"), []byte("oo\x05hel"), []byte("lo\x01"), []byte("!"))
+	sub := source.UnmarshalDelimited(newTextMessage).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []string{"foo", "hello", "!"}, texts)
+}
+
+func TestUnmarshalDelimitedWithTruncatedFrame(t *testing.T) {
+	texts := []string{}
+	var myerr error
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		texts = append(texts, item.(*textMessage).text)
+	})
+
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	source := Just([]byte("\x03foo\x05he"))
+	sub := source.UnmarshalDelimited(newTextMessage).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []string{"foo"}, texts)
+	assert.Error(t, myerr)
+}
+
+func TestMarshalUnmarshalDelimitedRoundTrip(t *testing.T) {
+	texts := []string{}
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		texts = append(texts, item.(*textMessage).text)
+	})
+
+	source := Just(&textMessage{"a"}, &textMessage{"bc"}, &textMessage{"def"})
+	sub := source.MarshalDelimited().UnmarshalDelimited(newTextMessage).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []string{"a", "bc", "def"}, texts)
+}
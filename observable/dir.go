@@ -0,0 +1,107 @@
+package observable
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// DirOptions configures the FromDir source.
+type DirOptions struct {
+	// Watch keeps scanning for newly arrived files until Term is closed.
+	Watch bool
+
+	// PollInterval is the time between two scans in Watch mode. It defaults
+	// to one second.
+	PollInterval time.Duration
+
+	// Term stops the scanning in Watch mode when closed.
+	Term chan struct{}
+
+	// OnDone, if set, is called with the path of a File once its Done method
+	// is called, for instance to archive the file after processing.
+	OnDone func(path string)
+}
+
+// File is a file found by FromDir.
+type File struct {
+	Path   string
+	onDone func(string)
+}
+
+// Open opens the File for reading.
+func (f File) Open() (*os.File, error) {
+	return os.Open(f.Path)
+}
+
+// Done notifies that the File has been processed.
+func (f File) Done() {
+	if f.onDone != nil {
+		f.onDone(f.Path)
+	}
+}
+
+// FromDir creates an Observable emitting a File for every path matching a
+// glob pattern, in lexical order. In Watch mode, the pattern is matched again
+// every PollInterval and only files which were not found by the previous scan
+// are emitted, so that a file archived and created again is emitted again.
+func FromDir(pattern string, opts DirOptions) Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	clock := CurrentClock()
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	go func() {
+		// emit sends an item unless the scanning is stopped first.
+		emit := func(item interface{}) bool {
+			select {
+			case source <- item:
+				return true
+			case <-opts.Term:
+			case <-quit:
+			}
+			return false
+		}
+
+		seen := make(map[string]struct{})
+	OuterLoop:
+		for {
+			matches, err := filepath.Glob(pattern)
+			if err != nil {
+				emit(errors.Wrap(errors.ObservableError, err, "invalid pattern"))
+				break OuterLoop
+			}
+
+			// Files gone since the previous scan are forgotten.
+			found := make(map[string]struct{}, len(matches))
+			for _, path := range matches {
+				found[path] = struct{}{}
+				if _, ok := seen[path]; ok {
+					continue
+				}
+				if !emit(File{Path: path, onDone: opts.OnDone}) {
+					break OuterLoop
+				}
+			}
+			seen = found
+
+			if !opts.Watch {
+				break OuterLoop
+			}
+
+			select {
+			case <-opts.Term:
+				break OuterLoop
+			case <-quit:
+				break OuterLoop
+			case <-clock.After(opts.PollInterval):
+			}
+		}
+		closeOut(source)
+	}()
+	return Observable(source)
+}
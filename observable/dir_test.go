@@ -0,0 +1,128 @@
+package observable
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rxgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"b.log", "a.log", "c.txt"} {
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644)
+	}
+
+	done := []string{}
+	opts := DirOptions{
+		OnDone: func(path string) {
+			done = append(done, filepath.Base(path))
+		},
+	}
+
+	contents := []string{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		file := item.(File)
+		f, err := file.Open()
+		if assert.NoError(t, err) {
+			b, _ := ioutil.ReadAll(f)
+			f.Close()
+			contents = append(contents, string(b))
+		}
+		file.Done()
+	})
+
+	sub := FromDir(filepath.Join(dir, "*.log"), opts).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []string{"a.log", "b.log"}, contents)
+	assert.Exactly(t, []string{"a.log", "b.log"}, done)
+}
+
+func TestFromDirWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rxgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "1.log"), nil, 0644)
+
+	term := make(chan struct{})
+	myStream := FromDir(filepath.Join(dir, "*.log"), DirOptions{
+		Watch:        true,
+		PollInterval: 5 * time.Millisecond,
+		Term:         term,
+	})
+
+	assert.Equal(t, "1.log", filepath.Base((<-myStream).(File).Path))
+
+	ioutil.WriteFile(filepath.Join(dir, "2.log"), nil, 0644)
+	assert.Equal(t, "2.log", filepath.Base((<-myStream).(File).Path))
+
+	close(term)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestFromDirWatchRecreated(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	dir, err := ioutil.TempDir("", "rxgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "1.log")
+	ioutil.WriteFile(path, nil, 0644)
+
+	term := make(chan struct{})
+	myStream := FromDir(filepath.Join(dir, "*.log"), DirOptions{
+		Watch:        true,
+		PollInterval: time.Minute,
+		Term:         term,
+	})
+	assert.Equal(t, path, (<-myStream).(File).Path)
+
+	// The file is archived, and a scan finds nothing.
+	os.Remove(path)
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Minute)
+	s.BlockUntil(1)
+
+	// A new file under the same name is emitted again.
+	ioutil.WriteFile(path, nil, 0644)
+	s.AdvanceBy(time.Minute)
+	assert.Equal(t, path, (<-myStream).(File).Path)
+
+	close(term)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestFromDirWithBadPattern(t *testing.T) {
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := FromDir("[", DirOptions{}).Subscribe(onError)
+	<-sub
+
+	assert.True(t, errors.Is(myerr, filepath.ErrBadPattern))
+}
@@ -0,0 +1,135 @@
+package observable
+
+import (
+	"time"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/fx"
+)
+
+// Timestamped pairs an item with the time it was observed.
+type Timestamped struct {
+	Value interface{}
+	Time  time.Time
+}
+
+// Downsample groups the items in the original Observable into fixed buckets of
+// the given duration and emits one Timestamped value per bucket, reduced by an
+// AggregateFunc such as DownsampleMean, DownsampleLast or DownsampleMax.
+// Items which are not Timestamped are stamped with their arrival time.
+func (o Observable) Downsample(bucket time.Duration, reduce fx.AggregateFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		var start time.Time
+		var values []interface{}
+
+		flush := func() bool {
+			if len(values) == 0 {
+				return true
+			}
+			result := reduce(values)
+			values = nil
+			if err, isErr := result.(error); isErr {
+				out <- err
+				return false
+			}
+			out <- Timestamped{Value: result, Time: start}
+			return true
+		}
+
+	OuterLoop:
+		for item := range o {
+			var ts Timestamped
+			switch item := item.(type) {
+			case error:
+				if flush() {
+					out <- item
+				}
+				break OuterLoop
+			case Timestamped:
+				ts = item
+			default:
+				ts = Timestamped{Value: item, Time: clock.Now()}
+			}
+
+			if begin := ts.Time.Truncate(bucket); begin.After(start) {
+				if !flush() {
+					break OuterLoop
+				}
+				start = begin
+			}
+			values = append(values, ts.Value)
+		}
+		flush()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// DownsampleMean reduces a bucket of numeric items to their float64 mean.
+func DownsampleMean(items []interface{}) interface{} {
+	sum := 0.0
+	for _, item := range items {
+		num, ok := toFloat64(item)
+		if !ok {
+			return errors.New(errors.ObservableError, "cannot average non-numeric item")
+		}
+		sum += num
+	}
+	return sum / float64(len(items))
+}
+
+// DownsampleLast reduces a bucket of items to the last one.
+func DownsampleLast(items []interface{}) interface{} {
+	return items[len(items)-1]
+}
+
+// DownsampleMax reduces a bucket of numeric items to the greatest one.
+func DownsampleMax(items []interface{}) interface{} {
+	var max interface{}
+	var maxNum float64
+	for _, item := range items {
+		num, ok := toFloat64(item)
+		if !ok {
+			return errors.New(errors.ObservableError, "cannot compare non-numeric item")
+		}
+		if max == nil || num > maxNum {
+			max, maxNum = item, num
+		}
+	}
+	return max
+}
+
+// toFloat64 converts any built-in numeric item to a float64.
+func toFloat64(item interface{}) (float64, bool) {
+	switch item := item.(type) {
+	case int:
+		return float64(item), true
+	case int8:
+		return float64(item), true
+	case int16:
+		return float64(item), true
+	case int32:
+		return float64(item), true
+	case int64:
+		return float64(item), true
+	case uint:
+		return float64(item), true
+	case uint8:
+		return float64(item), true
+	case uint16:
+		return float64(item), true
+	case uint32:
+		return float64(item), true
+	case uint64:
+		return float64(item), true
+	case float32:
+		return float64(item), true
+	case float64:
+		return item, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,61 @@
+package observable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownsample(t *testing.T) {
+	base := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration, value interface{}) Timestamped {
+		return Timestamped{Value: value, Time: base.Add(offset)}
+	}
+
+	tests := []struct {
+		reduce   func([]interface{}) interface{}
+		expected []interface{}
+	}{
+		{DownsampleMean, []interface{}{2.0, 5.0, 10.0}},
+		{DownsampleLast, []interface{}{3, 6, 10}},
+		{DownsampleMax, []interface{}{3, 6, 10}},
+	}
+
+	for _, tt := range tests {
+		source := Just(
+			at(0, 1), at(100*time.Millisecond, 2), at(900*time.Millisecond, 3),
+			at(time.Second, 4), at(1500*time.Millisecond, 6),
+			at(3*time.Second, 10),
+		)
+
+		values := []interface{}{}
+		times := []time.Time{}
+		onNext := handlers.NextFunc(func(item interface{}) {
+			ts := item.(Timestamped)
+			values = append(values, ts.Value)
+			times = append(times, ts.Time)
+		})
+
+		sub := source.Downsample(time.Second, tt.reduce).Subscribe(onNext)
+		<-sub
+
+		assert.Exactly(t, tt.expected, values)
+		assert.Exactly(t, []time.Time{base, base.Add(time.Second), base.Add(3 * time.Second)}, times)
+	}
+}
+
+func TestDownsampleWithNonNumericItems(t *testing.T) {
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just("foo", "bar").Downsample(time.Hour, DownsampleMean).Subscribe(observer.New(onError))
+	<-sub
+
+	assert.Error(t, myerr)
+}
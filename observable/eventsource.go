@@ -0,0 +1,56 @@
+package observable
+
+import (
+	"sync"
+)
+
+// FromEventSource wraps a callback-based API, such as OS signals or GUI
+// events, into an Observable. register is called right away with an emit func
+// for the listener to call; emitted values are queued without bound, so that
+// emit never blocks the caller. Emitting an error terminates the Observable.
+//
+// Disposing of a Subscription to the Observable, or emitting an error, calls
+// unregister once, which must detach the listener, and completes the
+// Observable. Values emitted afterwards are ignored. After an error,
+// unregister is called on a goroutine of its own, since emit is usually called
+// from within the listener.
+func FromEventSource(register func(emit func(interface{})), unregister func()) Observable {
+	in, out := Unbounded()
+	var mu sync.Mutex
+	closed := false
+
+	// stop completes the Observable and reports whether it was still open.
+	stop := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return false
+		}
+		closed = true
+		close(in)
+		return true
+	}
+
+	emit := func(item interface{}) {
+		mu.Lock()
+		if closed {
+			mu.Unlock()
+			return
+		}
+		in <- item
+		mu.Unlock()
+
+		if _, isErr := item.(error); isErr && stop() {
+			go unregister()
+		}
+	}
+
+	onStop(out, func() {
+		if stop() {
+			unregister()
+		}
+	})
+
+	register(emit)
+	return out
+}
@@ -0,0 +1,63 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSource is a callback-based API with a single listener.
+type fakeSource struct {
+	listener     func(interface{})
+	unregistered chan struct{}
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{unregistered: make(chan struct{})}
+}
+
+func (s *fakeSource) register(emit func(interface{})) {
+	s.listener = emit
+}
+
+func (s *fakeSource) unregister() {
+	close(s.unregistered)
+}
+
+func TestFromEventSource(t *testing.T) {
+	source := newFakeSource()
+	myStream := FromEventSource(source.register, source.unregister)
+
+	items := make(chan interface{}, 2)
+	sub, subs := myStream.SubscribeDisposable(handlers.NextFunc(func(item interface{}) {
+		items <- item
+	}))
+	source.listener("click")
+	source.listener("scroll")
+	assert.Equal(t, "click", <-items)
+	assert.Equal(t, "scroll", <-items)
+
+	// Disposing of the Subscription unregisters the listener.
+	sub.Dispose()
+	<-subs
+	<-source.unregistered
+	source.listener("ignored")
+	assert.True(t, waitClosed(myStream))
+	assert.Empty(t, items)
+}
+
+func TestFromEventSourceWithError(t *testing.T) {
+	source := newFakeSource()
+	myStream := FromEventSource(source.register, source.unregister)
+
+	myerr := errors.New("bang")
+	source.listener("click")
+	source.listener(myerr)
+	source.listener("ignored")
+
+	<-source.unregistered
+	assert.Exactly(t, []interface{}{"click", myerr}, drain(myStream))
+}
@@ -0,0 +1,149 @@
+package observable
+
+import (
+	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// Factory creates a fresh Observable each time it is called, such as a
+// closure over Start or Just. Since an Observable can only be consumed once,
+// the operators re-subscribing to a source are defined on Factory.
+type Factory func() Observable
+
+// Defer returns a Factory which leaves creating the Observable to factory
+// until it is subscribed to, so that every subscriber gets a fresh source,
+// such as a new HTTP request, instead of sharing a drained one.
+func Defer(factory func() Observable) Factory {
+	return Factory(factory)
+}
+
+// ColdJust returns a Factory of Observables emitting the provided item(s), so
+// that each subscriber receives all of them, unlike with a shared Just.
+func ColdJust(item interface{}, items ...interface{}) Factory {
+	return func() Observable {
+		return Just(item, items...)
+	}
+}
+
+// ColdRange returns a Factory of Observables emitting a particular range of
+// sequential integers, so that each subscriber receives all of them, unlike
+// with a shared Range.
+func ColdRange(start, end int) Factory {
+	return func() Observable {
+		return Range(start, end)
+	}
+}
+
+// Hot creates a single Observable from the Factory, to be shared by every
+// consumer, each item going to only one of them.
+func (f Factory) Hot() Observable {
+	return f()
+}
+
+// Subscribe creates a fresh Observable and subscribes the handler to it.
+func (f Factory) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	return f().Subscribe(handler)
+}
+
+// Repeat mirrors an Observable created by the Factory and, once it completes,
+// creates a new one in its place, so that the source is subscribed to count
+// times in all, or forever if count is negative. This suits polling loops.
+// An error is passed on and terminates the new Observable.
+func (f Factory) Repeat(count int) Observable {
+	out := make(chan interface{})
+	r := newRelay(out)
+	go func() {
+	OuterLoop:
+		for i := 0; count < 0 || i < count; i++ {
+			source := f()
+			if !r.follow(source) {
+				break
+			}
+			for item := range source {
+				if !trySend(out, item, r.quit) {
+					cancelUpstream(source)
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Retry mirrors an Observable created by the Factory and, whenever it emits an
+// error, creates a new one in its place, up to count times. The items emitted
+// before an error are passed on; the last error is emitted once the retries
+// are exhausted.
+func (f Factory) Retry(count int) Observable {
+	return f.RetryWhen(func(err error, attempt int) bool {
+		return attempt <= count
+	})
+}
+
+// RetryWhen mirrors an Observable created by the Factory and, whenever it
+// emits an error, asks predicate with that error and the retry attempt,
+// counted from one, whether to create a new one in its place. The items
+// emitted before an error are passed on; the error is emitted once the
+// predicate declines to retry.
+func (f Factory) RetryWhen(predicate func(error, int) bool) Observable {
+	return f.retry(func(err error, attempt int, quit <-chan struct{}) bool {
+		return predicate(err, attempt)
+	})
+}
+
+// RetryBackoff mirrors an Observable created by the Factory and, whenever it
+// emits an error, creates a new one in its place after waiting as long as the
+// BackoffPolicy tells it to. The items emitted before an error are passed on;
+// the error is emitted once the policy gives up.
+func (f Factory) RetryBackoff(policy BackoffPolicy) Observable {
+	clock := CurrentClock()
+	return f.retry(func(err error, attempt int, quit <-chan struct{}) bool {
+		delay, retry := policy.Backoff(attempt)
+		if retry {
+			select {
+			case <-clock.After(delay):
+			case <-quit:
+				return false
+			}
+		}
+		return retry
+	})
+}
+
+// retry implements RetryWhen, with a predicate which may wait until quit is
+// closed, once the new Observable is stopped.
+func (f Factory) retry(predicate func(err error, attempt int, quit <-chan struct{}) bool) Observable {
+	out := make(chan interface{})
+	r := newRelay(out)
+	go func() {
+		attempt := 0
+	OuterLoop:
+		for {
+			source := f()
+			if !r.follow(source) {
+				break
+			}
+			for item := range source {
+				if err, isErr := item.(error); isErr {
+					attempt++
+					if predicate(err, attempt, r.quit) {
+						continue OuterLoop
+					}
+					trySend(out, err, r.quit)
+					break OuterLoop
+				}
+				if !trySend(out, item, r.quit) {
+					cancelUpstream(source)
+					break OuterLoop
+				}
+			}
+			break
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
@@ -0,0 +1,168 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/stretchr/testify/assert"
+)
+
+// flaky returns a Factory whose Observables fail with myerr until they have
+// been created the given number of times.
+func flaky(failures int, myerr error) (Factory, *int) {
+	calls := 0
+	return func() Observable {
+		calls++
+		if calls <= failures {
+			return Just(calls, myerr)
+		}
+		return Just(calls)
+	}, &calls
+}
+
+func TestDefer(t *testing.T) {
+	calls := 0
+	factory := Defer(func() Observable {
+		calls++
+		return Just(calls)
+	})
+	assert.Equal(t, 0, calls)
+
+	items := []interface{}{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		items = append(items, item)
+	})
+	<-factory.Subscribe(onNext)
+	<-factory.Subscribe(onNext)
+
+	assert.Exactly(t, []interface{}{1, 2}, items)
+	assert.Equal(t, 2, calls)
+}
+
+func TestColdConstructors(t *testing.T) {
+	just := ColdJust(1, 2)
+	assert.Exactly(t, []interface{}{1, 2}, drain(just.Hot()))
+	assert.Exactly(t, []interface{}{1, 2}, drain(just.Hot()))
+
+	nums := []interface{}{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item)
+	})
+	rng := ColdRange(0, 3)
+	<-rng.Subscribe(onNext)
+	<-rng.Subscribe(onNext)
+	assert.Exactly(t, []interface{}{0, 1, 2, 0, 1, 2}, nums)
+}
+
+func TestFactoryRepeat(t *testing.T) {
+	calls := 0
+	factory := Factory(func() Observable {
+		calls++
+		return Just(calls, "tick")
+	})
+
+	items := []interface{}{}
+	for item := range factory.Repeat(3) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, "tick", 2, "tick", 3, "tick"}, items)
+
+	items = []interface{}{}
+	for item := range factory.Repeat(-1).Take(4) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{4, "tick", 5, "tick"}, items)
+
+	items = []interface{}{}
+	for item := range factory.Repeat(0) {
+		items = append(items, item)
+	}
+	assert.Empty(t, items)
+}
+
+func TestFactoryRepeatDisposed(t *testing.T) {
+	assertStopped(t, "Repeat", func() Observable {
+		return ColdJust(1).Repeat(-1)
+	})
+}
+
+func TestFactoryRepeatWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	factory, calls := flaky(1, myerr)
+
+	items := []interface{}{}
+	for item := range factory.Repeat(3) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, myerr}, items)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestFactoryRetry(t *testing.T) {
+	myerr := errors.New("transient")
+	factory, calls := flaky(2, myerr)
+
+	items := []interface{}{}
+	for item := range factory.Retry(3) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, 3}, items)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestFactoryRetryExhausted(t *testing.T) {
+	myerr := errors.New("permanent")
+	factory, calls := flaky(5, myerr)
+
+	items := []interface{}{}
+	for item := range factory.Retry(2) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, 3, myerr}, items)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestFactoryRetryWhen(t *testing.T) {
+	transient := errors.New("transient")
+	permanent := errors.New("permanent")
+	calls := 0
+	factory := Factory(func() Observable {
+		calls++
+		if calls == 1 {
+			return Just(transient)
+		}
+		return Just(permanent)
+	})
+
+	attempts := []int{}
+	isTransient := func(err error, attempt int) bool {
+		attempts = append(attempts, attempt)
+		return err == transient
+	}
+
+	items := []interface{}{}
+	for item := range factory.RetryWhen(isTransient) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{permanent}, items)
+	assert.Exactly(t, []int{1, 2}, attempts)
+}
+
+func TestFactoryRetryBackoff(t *testing.T) {
+	myerr := errors.New("transient")
+	factory, calls := flaky(5, myerr)
+	policy := ExponentialBackoff{Initial: time.Millisecond, MaxAttempts: 3}
+
+	items := []interface{}{}
+	for item := range factory.RetryBackoff(policy) {
+		items = append(items, item)
+	}
+
+	assert.Exactly(t, []interface{}{1, 2, 3, 4, myerr}, items)
+	assert.Equal(t, 4, *calls)
+}
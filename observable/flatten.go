@@ -0,0 +1,91 @@
+package observable
+
+// SwitchMap applies a function creating an Observable to each item in the
+// original Observable and mirrors only the most recent of those inner
+// Observables, ceasing to consume the previous one as soon as a new item
+// arrives. This suits type-ahead searches, where only the answer to the latest
+// query matters. The new Observable completes once the original Observable
+// and the current inner Observable have both completed. The first error, from
+// the original or an inner Observable, is passed on and terminates the new
+// Observable.
+func (o Observable) SwitchMap(apply func(interface{}) Observable) Observable {
+	out := make(chan interface{})
+	r := newRelay(out, o)
+	go func() {
+		outer := o
+		var inner Observable
+
+	OuterLoop:
+		for outer != nil || inner != nil {
+			select {
+			case item, ok := <-outer:
+				if !ok {
+					outer = nil
+					continue
+				}
+				if _, isErr := item.(error); isErr {
+					out <- item
+					break OuterLoop
+				}
+				if inner != nil {
+					cancelUpstream(inner)
+					inner = nil
+				}
+				if next := apply(item); r.follow(next) {
+					inner = next
+				}
+			case item, ok := <-inner:
+				if !ok {
+					inner = nil
+					continue
+				}
+				out <- item
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		// An error leaves the other Observable open.
+		if outer != nil {
+			cancelUpstream(outer)
+		}
+		if inner != nil {
+			cancelUpstream(inner)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// ConcatMap applies a function creating an Observable to each item in the
+// original Observable and emits the items of those inner Observables strictly
+// one after the other, in the order of the original items, unlike FlatMap.
+// The next inner Observable is only created once the previous one has
+// completed. The first error, from the original or an inner Observable, is
+// passed on and terminates the new Observable.
+func (o Observable) ConcatMap(apply func(interface{}) Observable) Observable {
+	out := make(chan interface{})
+	r := newRelay(out, o)
+	go func() {
+	OuterLoop:
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
+			inner := apply(item)
+			if !r.follow(inner) {
+				continue
+			}
+			for item := range inner {
+				out <- item
+				if _, isErr := item.(error); isErr {
+					cancelUpstream(o)
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
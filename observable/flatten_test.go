@@ -0,0 +1,130 @@
+package observable
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwitchMap(t *testing.T) {
+	source := make(chan interface{})
+	inners := map[interface{}]chan interface{}{
+		"a": make(chan interface{}),
+		"b": make(chan interface{}),
+	}
+	myStream := Observable(source).SwitchMap(func(item interface{}) Observable {
+		return Observable(inners[item])
+	})
+
+	source <- "a"
+	inners["a"] <- "a1"
+	assert.Equal(t, "a1", <-myStream)
+
+	source <- "b"
+	inners["b"] <- "b1"
+	assert.Equal(t, "b1", <-myStream)
+
+	// The previous inner Observable is cancelled: its items are discarded.
+	inners["a"] <- "a2"
+	close(inners["a"])
+
+	close(source)
+	inners["b"] <- "b2"
+	assert.Equal(t, "b2", <-myStream)
+	close(inners["b"])
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestSwitchMapWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	myStream := Just(1, 2).SwitchMap(func(item interface{}) Observable {
+		if item == 2 {
+			return Just(myerr, 3)
+		}
+		return Never()
+	})
+	assert.Exactly(t, []interface{}{myerr}, drain(myStream))
+
+	myStream = Just(1, myerr).SwitchMap(func(item interface{}) Observable {
+		return Never()
+	})
+	assert.Exactly(t, []interface{}{myerr}, drain(myStream))
+}
+
+func TestSwitchMapStopsInner(t *testing.T) {
+	ticking := func(interface{}) Observable {
+		return Interval(nil, time.Millisecond)
+	}
+	before := runtime.NumGoroutine()
+
+	// Every inner Observable but the current one is stopped on a switch, and
+	// the current one on Dispose.
+	source := make(chan interface{})
+	sub, subs := Observable(source).SwitchMap(ticking).SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+	for i := 0; i < 10; i++ {
+		source <- i
+	}
+	sub.Dispose()
+	<-subs
+	close(source)
+
+	deadline := time.After(time.Second)
+	for runtime.NumGoroutine() > before {
+		select {
+		case <-deadline:
+			assert.Fail(t, "inner Observables left running", "%d goroutines instead of %d",
+				runtime.NumGoroutine(), before)
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	// An error of the inner Observable stops the original one.
+	outer := Interval(nil, time.Millisecond)
+	drain(outer.SwitchMap(func(interface{}) Observable {
+		return Throw(errors.New("bang"))
+	}))
+	assert.True(t, waitClosed(outer))
+}
+
+func TestConcatMapDisposed(t *testing.T) {
+	assertStopped(t, "ConcatMap", func() Observable {
+		return Just(1, 2).ConcatMap(func(interface{}) Observable {
+			return Interval(nil, time.Millisecond)
+		})
+	})
+}
+
+func TestConcatMap(t *testing.T) {
+	created := []interface{}{}
+	myStream := Just(1, 2, 3).ConcatMap(func(item interface{}) Observable {
+		created = append(created, item)
+		n := item.(int)
+		return Just(n*10, n*10+1)
+	})
+
+	assert.Exactly(t, []interface{}{10, 11, 20, 21, 30, 31}, drain(myStream))
+	assert.Exactly(t, []interface{}{1, 2, 3}, created)
+}
+
+func TestConcatMapWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	myStream := Just(1, 2, 3).ConcatMap(func(item interface{}) Observable {
+		if item == 2 {
+			return Just(item, myerr)
+		}
+		return Just(item)
+	})
+	assert.Exactly(t, []interface{}{1, 2, myerr}, drain(myStream))
+
+	myStream = Just(1, myerr, 2).ConcatMap(func(item interface{}) Observable {
+		return Just(item)
+	})
+	assert.Exactly(t, []interface{}{1, myerr}, drain(myStream))
+}
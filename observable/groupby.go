@@ -0,0 +1,48 @@
+package observable
+
+import (
+	"github.com/reactivex/rxgo/fx"
+)
+
+// GroupedObservable is an Observable of the items sharing the same Key.
+type GroupedObservable struct {
+	Observable
+	Key interface{}
+}
+
+// GroupBy partitions the items in the original Observable by the key returned
+// by a KeySelectorFunc, and returns a new Observable emitting a
+// GroupedObservable for each key when its first item arrives. Groups are
+// queued without bound so that a group which is not consumed never stalls the
+// others. An error is passed on to every group and to the new Observable.
+func (o Observable) GroupBy(apply fx.KeySelectorFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		groups := make(map[interface{}]chan<- interface{})
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				for _, group := range groups {
+					group <- item
+				}
+				out <- item
+				break
+			}
+
+			key := apply(item)
+			group, ok := groups[key]
+			if !ok {
+				var g Observable
+				group, g = Unbounded()
+				groups[key] = group
+				out <- GroupedObservable{Observable: g, Key: key}
+			}
+			group <- item
+		}
+		for _, group := range groups {
+			close(group)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
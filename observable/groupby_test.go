@@ -0,0 +1,44 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	statusClass := func(item interface{}) interface{} {
+		return item.(int) / 100
+	}
+
+	groups := []GroupedObservable{}
+	for group := range Just(200, 404, 204, 500, 410).GroupBy(statusClass) {
+		groups = append(groups, group.(GroupedObservable))
+	}
+
+	assert.Len(t, groups, 3)
+	assert.Equal(t, 2, groups[0].Key)
+	assert.Exactly(t, []interface{}{200, 204}, drain(groups[0].Observable))
+	assert.Equal(t, 4, groups[1].Key)
+	assert.Exactly(t, []interface{}{404, 410}, drain(groups[1].Observable))
+	assert.Equal(t, 5, groups[2].Key)
+	assert.Exactly(t, []interface{}{500}, drain(groups[2].Observable))
+}
+
+func TestGroupByWithError(t *testing.T) {
+	parity := func(item interface{}) interface{} {
+		return item.(int) % 2
+	}
+
+	myerr := errors.New("bang")
+	items := []interface{}{}
+	for item := range Just(1, 2, myerr, 3).GroupBy(parity) {
+		items = append(items, item)
+	}
+
+	assert.Len(t, items, 3)
+	assert.Exactly(t, []interface{}{1, myerr}, drain(items[0].(GroupedObservable).Observable))
+	assert.Exactly(t, []interface{}{2, myerr}, drain(items[1].(GroupedObservable).Observable))
+	assert.Equal(t, myerr, items[2])
+}
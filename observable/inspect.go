@@ -0,0 +1,176 @@
+package observable
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Inspector collects runtime statistics about the stages of a pipeline which
+// are marked with the Inspect operator, to help finding its bottleneck.
+type Inspector struct {
+	mu     sync.Mutex
+	stages []*stage
+	edges  []Edge
+}
+
+// StageStats is a snapshot of the statistics of an inspected stage.
+type StageStats struct {
+	// Name is the name given to the stage.
+	Name string
+
+	// Emitted counts the items which went through the stage.
+	Emitted uint64
+
+	// Blocked reports whether the stage is waiting for downstream to accept
+	// an item, which means the bottleneck is further down the pipeline.
+	Blocked bool
+
+	// Buffered counts the items waiting to enter the stage: the ones held in
+	// the buffer of its source channel, and in the queue of the buffering
+	// operator feeding it, such as OnBackpressureBuffer or ObserveOn.
+	Buffered int
+
+	// LastActivity is the last time an item went through the stage, as told
+	// by the current Clock.
+	LastActivity time.Time
+}
+
+type stage struct {
+	source Observable
+	output Observable
+	params []string
+	stats  StageStats
+}
+
+// NewInspector creates an Inspector with no stage.
+func NewInspector() *Inspector {
+	return &Inspector{}
+}
+
+// Stats returns the statistics of every inspected stage in the order they
+// were created.
+func (in *Inspector) Stats() []StageStats {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	stats := make([]StageStats, len(in.stages))
+	for i, s := range in.stages {
+		stats[i] = s.stats
+		stats[i].Buffered = len(s.source) + queueDepth(s.source)
+	}
+	return stats
+}
+
+// Inspect mirrors the original Observable as a stage of the given name, whose
+// statistics are reported by an Inspector. Optional params describe the stage
+// in the Inspector's Topology.
+func (o Observable) Inspect(in *Inspector, name string, params ...interface{}) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	s := &stage{source: o, output: Observable(out), stats: StageStats{Name: name}}
+	for _, param := range params {
+		s.params = append(s.params, fmt.Sprint(param))
+	}
+
+	in.mu.Lock()
+	in.stages = append(in.stages, s)
+	in.mu.Unlock()
+
+	clock := CurrentClock()
+	go func() {
+		for item := range o {
+			in.mu.Lock()
+			s.stats.Blocked = true
+			in.mu.Unlock()
+
+			out <- item
+
+			in.mu.Lock()
+			s.stats.Blocked = false
+			s.stats.Emitted++
+			s.stats.LastActivity = clock.Now()
+			in.mu.Unlock()
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Topology describes the graph of the stages of an Inspector.
+type Topology struct {
+	Stages []Node `json:"stages"`
+	Edges  []Edge `json:"edges"`
+}
+
+// Node is an inspected stage in a Topology.
+type Node struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name"`
+	Params []string `json:"params,omitempty"`
+}
+
+// Edge links two stages of a Topology by their ID.
+type Edge struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// Connect declares that items flow from the first stage with the name from
+// to the first stage with the name to. Stages which directly read from each
+// other are connected automatically, but the ones separated by operators,
+// such as the sources of a fan-in, need to be connected explicitly.
+func (in *Inspector) Connect(from, to string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	edge := Edge{From: -1, To: -1}
+	for i := len(in.stages) - 1; i >= 0; i-- {
+		if in.stages[i].stats.Name == from {
+			edge.From = i
+		}
+		if in.stages[i].stats.Name == to {
+			edge.To = i
+		}
+	}
+	if edge.From >= 0 && edge.To >= 0 {
+		in.edges = append(in.edges, edge)
+	}
+}
+
+// Topology returns the graph of the inspected stages.
+func (in *Inspector) Topology() Topology {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	t := Topology{Stages: []Node{}, Edges: []Edge{}}
+	for i, s := range in.stages {
+		t.Stages = append(t.Stages, Node{ID: i, Name: s.stats.Name, Params: s.params})
+		for j, upstream := range in.stages {
+			if upstream.output == s.source {
+				t.Edges = append(t.Edges, Edge{From: j, To: i})
+			}
+		}
+	}
+	t.Edges = append(t.Edges, in.edges...)
+	return t
+}
+
+// DOT renders a Topology in the Graphviz DOT language.
+func (t Topology) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph pipeline {\n")
+	for _, node := range t.Stages {
+		label := node.Name
+		if len(node.Params) > 0 {
+			label += fmt.Sprint(node.Params)
+		}
+		fmt.Fprintf(&buf, "\t%d [label=%q];\n", node.ID, label)
+	}
+	for _, edge := range t.Edges {
+		fmt.Fprintf(&buf, "\t%d -> %d;\n", edge.From, edge.To)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
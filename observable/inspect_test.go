@@ -0,0 +1,136 @@
+package observable
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservableInspect(t *testing.T) {
+	in := NewInspector()
+	source := make(chan interface{}, 4)
+	source <- 1
+	source <- 2
+	source <- 3
+
+	mapped := Observable(source).
+		Inspect(in, "source").
+		Map(func(item interface{}) interface{} {
+			return item.(int) * 10
+		}).
+		Inspect(in, "map")
+
+	assert.Equal(t, 10, <-mapped)
+	assert.Equal(t, 20, <-mapped)
+	<-time.After(10 * time.Millisecond)
+
+	stats := in.Stats()
+	if assert.Len(t, stats, 2) {
+		assert.Equal(t, "source", stats[0].Name)
+		assert.Equal(t, "map", stats[1].Name)
+
+		assert.EqualValues(t, 2, stats[1].Emitted)
+		assert.True(t, stats[1].Blocked)
+		assert.Equal(t, 0, stats[1].Buffered)
+		assert.False(t, stats[1].LastActivity.IsZero())
+		assert.True(t, stats[0].Emitted >= 2)
+		assert.Equal(t, 0, stats[0].Buffered)
+	}
+
+	close(source)
+	for range mapped {
+	}
+
+	stats = in.Stats()
+	assert.EqualValues(t, 3, stats[0].Emitted)
+	assert.EqualValues(t, 3, stats[1].Emitted)
+	assert.False(t, stats[1].Blocked)
+}
+
+func TestInspectBuffered(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	in := NewInspector()
+	source := make(chan interface{}, 4)
+	source <- 1
+	source <- 2
+	buffered := Observable(source).Inspect(in, "source")
+
+	push, queued := Unbounded()
+	for i := 0; i < 3; i++ {
+		push <- i
+	}
+	unbounded := queued.Inspect(in, "unbounded")
+	<-time.After(10 * time.Millisecond)
+
+	// Each stage holds the first item while waiting to emit it.
+	stats := in.Stats()
+	assert.Equal(t, 1, stats[0].Buffered)
+	assert.Equal(t, 2, stats[1].Buffered)
+
+	s.AdvanceBy(time.Minute)
+	assert.Equal(t, 1, <-buffered)
+	assert.Equal(t, 0, <-unbounded)
+	<-time.After(10 * time.Millisecond)
+
+	stats = in.Stats()
+	assert.Equal(t, 0, stats[0].Buffered)
+	assert.Equal(t, 1, stats[1].Buffered)
+	assert.Equal(t, s.Now(), stats[0].LastActivity)
+	assert.Equal(t, s.Now(), stats[1].LastActivity)
+
+	close(source)
+	close(push)
+	for range buffered {
+	}
+	for range unbounded {
+	}
+	assert.Equal(t, 0, in.Stats()[1].Buffered)
+}
+
+func TestInspectorTopology(t *testing.T) {
+	in := NewInspector()
+
+	left := Just(1).Inspect(in, "left")
+	right := Just(2).Inspect(in, "right")
+	merged := Observable(make(chan interface{})).Inspect(in, "merged", "fan-in", 2)
+	merged.Inspect(in, "sink")
+
+	in.Connect("left", "merged")
+	in.Connect("right", "merged")
+	in.Connect("right", "unknown")
+
+	topology := in.Topology()
+	assert.Equal(t, []Node{
+		{ID: 0, Name: "left"},
+		{ID: 1, Name: "right"},
+		{ID: 2, Name: "merged", Params: []string{"fan-in", "2"}},
+		{ID: 3, Name: "sink"},
+	}, topology.Stages)
+	assert.Equal(t, []Edge{{2, 3}, {0, 2}, {1, 2}}, topology.Edges)
+
+	expected := "digraph pipeline {\n" +
+		"\t0 [label=\"left\"];\n" +
+		"\t1 [label=\"right\"];\n" +
+		"\t2 [label=\"merged[fan-in 2]\"];\n" +
+		"\t3 [label=\"sink\"];\n" +
+		"\t2 -> 3;\n" +
+		"\t0 -> 2;\n" +
+		"\t1 -> 2;\n" +
+		"}\n"
+	assert.Equal(t, expected, topology.DOT())
+
+	b, err := json.Marshal(topology)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"edges":[{"from":2,"to":3}`)
+
+	for range left {
+	}
+	for range right {
+	}
+}
@@ -0,0 +1,64 @@
+package observable
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// FromReader creates an Observable emitting, as []byte, each token read from
+// r as split by a bufio.SplitFunc, such as bufio.ScanLines, the default if
+// split is nil, bufio.ScanWords or bufio.ScanRunes. A read error is emitted as
+// the cause of an ObservableError, and terminates the Observable.
+func FromReader(r io.Reader, split bufio.SplitFunc) Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		if split != nil {
+			scanner.Split(split)
+		}
+		for scanner.Scan() {
+			token := make([]byte, len(scanner.Bytes()))
+			copy(token, scanner.Bytes())
+			if !trySend(source, token, quit) {
+				closeOut(source)
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			trySend(source, errors.Wrap(errors.ObservableError, err, "read failed"), quit)
+		}
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// ToWriter blocks until the Observable completes, writing each item to w as
+// encoded by encode. A nil encode writes []byte and string items as is, and
+// fails on any other item. It returns the error the Observable emitted, or
+// an ObservableError caused by the first write error, after which the
+// Observable is no longer read.
+func (o Observable) ToWriter(w io.Writer, encode func(interface{}) []byte) error {
+	for item := range o {
+		if err, isErr := item.(error); isErr {
+			return err
+		}
+
+		var data []byte
+		if encode != nil {
+			data = encode(item)
+		} else if b, ok := item.([]byte); ok {
+			data = b
+		} else if s, ok := item.(string); ok {
+			data = []byte(s)
+		} else {
+			return errors.New(errors.ObservableError, "item is neither []byte nor string")
+		}
+		if _, err := w.Write(data); err != nil {
+			return errors.Wrap(errors.ObservableError, err, "write failed")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package observable
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errBang = errors.New("bang")
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errBang
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errBang
+}
+
+func TestFromReader(t *testing.T) {
+	lines := drain(FromReader(strings.NewReader("foo\nbar\r\nbaz"), nil))
+	assert.Exactly(t, []interface{}{[]byte("foo"), []byte("bar"), []byte("baz")}, lines)
+
+	words := drain(FromReader(strings.NewReader(" hello  world "), bufio.ScanWords))
+	assert.Exactly(t, []interface{}{[]byte("hello"), []byte("world")}, words)
+
+	items := drain(FromReader(failingReader{}, nil))
+	if assert.Len(t, items, 1) {
+		assert.True(t, errors.Is(items[0].(error), errBang))
+		assert.True(t, errors.Is(items[0].(error), rxerrors.New(rxerrors.ObservableError)))
+	}
+}
+
+func TestToWriter(t *testing.T) {
+	var buf bytes.Buffer
+	err := Just("foo", []byte("bar")).ToWriter(&buf, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "foobar", buf.String())
+
+	buf.Reset()
+	err = Just(1, 2).ToWriter(&buf, func(item interface{}) []byte {
+		return []byte(fmt.Sprintf("%v\n", item))
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1\n2\n", buf.String())
+
+	buf.Reset()
+	err = Just("foo", 42).ToWriter(&buf, nil)
+	assert.Error(t, err)
+	assert.Equal(t, "foo", buf.String())
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, Just("foo", myerr).ToWriter(&buf, nil))
+
+	err = Just("foo").ToWriter(failingWriter{}, nil)
+	assert.True(t, errors.Is(err, errBang))
+}
@@ -0,0 +1,92 @@
+package observable
+
+import (
+	"sync"
+)
+
+// Finally mirrors the original Observable and calls fn once it has completed
+// or emitted an error, or once a Subscription to the new Observable has been
+// disposed of, before the new Observable completes. It is meant to release
+// the resources held by the source, such as files or connections.
+func (o Observable) Finally(fn func()) Observable {
+	out := make(chan interface{})
+	quit := make(chan struct{})
+	var once sync.Once
+	onStop(out, func() {
+		once.Do(func() {
+			close(quit)
+		})
+	})
+
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case <-quit:
+				cancelUpstream(o)
+				break OuterLoop
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				select {
+				case out <- item:
+				case <-quit:
+					cancelUpstream(o)
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		fn()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// DoOnDispose mirrors the original Observable and returns a dispose func with
+// it. Calling dispose stops reading the original Observable, calls fn and
+// completes the new Observable; fn is not called if the original Observable
+// has already completed or emitted an error. Dispose may be called many times.
+// Disposing of a Subscription to the new Observable calls dispose as well.
+func (o Observable) DoOnDispose(fn func()) (Observable, func()) {
+	out := make(chan interface{})
+	quit := make(chan struct{})
+	var once sync.Once
+	dispose := func() {
+		once.Do(func() {
+			close(quit)
+		})
+	}
+	onStop(out, dispose)
+
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case <-quit:
+				fn()
+				cancelUpstream(o)
+				break OuterLoop
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				select {
+				case out <- item:
+				case <-quit:
+					fn()
+					cancelUpstream(o)
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out), dispose
+}
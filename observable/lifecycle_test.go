@@ -0,0 +1,89 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinally(t *testing.T) {
+	released := 0
+	release := func() {
+		released++
+	}
+
+	// fn is not called while items flow.
+	source := make(chan interface{})
+	myStream := Observable(source).Finally(release)
+	items := []interface{}{}
+	for _, n := range []int{1, 2} {
+		source <- n
+		items = append(items, <-myStream)
+		assert.Equal(t, 0, released)
+	}
+	close(source)
+	_, ok := <-myStream
+	assert.False(t, ok)
+	assert.Exactly(t, []interface{}{1, 2}, items)
+	assert.Equal(t, 1, released)
+
+	myerr := errors.New("bang")
+	items = []interface{}{}
+	for item := range Just(1, myerr, 2).Finally(release) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, myerr}, items)
+	assert.Equal(t, 2, released)
+}
+
+func TestFinallyOnDispose(t *testing.T) {
+	released := make(chan struct{})
+	source := make(chan interface{})
+	myStream := Observable(source).Finally(func() {
+		close(released)
+	})
+
+	sub, subs := myStream.SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+	source <- 1
+	sub.Dispose()
+	<-subs
+
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		assert.Fail(t, "fn not called after Dispose")
+	}
+}
+
+func TestDoOnDispose(t *testing.T) {
+	disposed := 0
+	source := make(chan interface{})
+	myStream, dispose := Observable(source).DoOnDispose(func() {
+		disposed++
+	})
+
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+
+	dispose()
+	dispose()
+	_, ok := <-myStream
+	assert.False(t, ok)
+	assert.Equal(t, 1, disposed)
+}
+
+func TestDoOnDisposeAfterCompletion(t *testing.T) {
+	disposed := false
+	myStream, dispose := Just(1).DoOnDispose(func() {
+		disposed = true
+	})
+
+	for range myStream {
+	}
+	dispose()
+	assert.False(t, disposed)
+}
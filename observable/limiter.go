@@ -0,0 +1,227 @@
+package observable
+
+import (
+	"math"
+	"sync"
+
+	"github.com/reactivex/rxgo/fx"
+)
+
+// Limiter bounds the number of workers run concurrently by operators such as
+// MapConcurrent. Its limit can be changed at runtime, for instance in response
+// to downstream latency, and operators sharing it grow or shrink accordingly.
+type Limiter struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	limit  int
+	active int
+}
+
+// NewLimiter creates a Limiter allowing up to limit concurrent workers.
+func NewLimiter(limit int) *Limiter {
+	l := &Limiter{}
+	l.cond = sync.NewCond(&l.mu)
+	l.SetLimit(limit)
+	return l
+}
+
+// Limit returns the current concurrency limit.
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// SetLimit changes the concurrency limit, which is at least one. Lowering it
+// lets the workers already running finish but holds back new ones until
+// the number of active workers drops below the new limit.
+func (l *Limiter) SetLimit(limit int) {
+	if limit < 1 {
+		limit = 1
+	}
+	l.mu.Lock()
+	l.limit = limit
+	l.mu.Unlock()
+	l.cond.Broadcast()
+}
+
+// Active returns the number of workers currently running.
+func (l *Limiter) Active() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
+
+func (l *Limiter) acquire() {
+	l.mu.Lock()
+	for l.active >= l.limit {
+		l.cond.Wait()
+	}
+	l.active++
+	l.mu.Unlock()
+}
+
+func (l *Limiter) release() {
+	l.mu.Lock()
+	l.active--
+	l.mu.Unlock()
+	l.cond.Broadcast()
+}
+
+// MapConcurrent maps a MappableFunc predicate to each item in Observable using
+// as many concurrent workers as the Limiter allows, and returns a new Observable
+// with applied items in the order they complete.
+func (o Observable) MapConcurrent(apply fx.MappableFunc, limiter *Limiter) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var wg sync.WaitGroup
+		var failure error
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				failure = err
+				break
+			}
+
+			limiter.acquire()
+			wg.Add(1)
+			go func(item interface{}) {
+				defer wg.Done()
+				defer limiter.release()
+				out <- apply(item)
+			}(item)
+		}
+
+		// Let in-flight workers deliver their results before the error.
+		wg.Wait()
+		if failure != nil {
+			out <- failure
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// FlatMap applies a function creating an Observable to each item in the
+// original Observable and merges the items of up to maxConcurrency of those
+// inner Observables at a time into a new Observable, or of all of them if
+// maxConcurrency is not positive. The first error, from the original or an
+// inner Observable, is passed on and terminates the new Observable.
+func (o Observable) FlatMap(apply func(interface{}) Observable, maxConcurrency int) Observable {
+	if maxConcurrency <= 0 {
+		maxConcurrency = math.MaxInt32
+	}
+	return o.FlatMapWithLimiter(apply, NewLimiter(maxConcurrency))
+}
+
+// FlatMapWithLimiter is like FlatMap but bounds the number of inner
+// Observables merged at a time with a Limiter, whose limit can be changed
+// while the items flow.
+func (o Observable) FlatMapWithLimiter(apply func(interface{}) Observable, limiter *Limiter) Observable {
+	out := make(chan interface{})
+	link(out, o)
+
+	// live holds the inner Observables being merged, which are stopped along
+	// with out, or cancelled once an error terminates it.
+	var mu sync.Mutex
+	live := map[Observable]struct{}{}
+	stopped := false
+	stopInners := func(stop func(Observable)) {
+		mu.Lock()
+		stopped = true
+		inners := make([]Observable, 0, len(live))
+		for inner := range live {
+			inners = append(inners, inner)
+		}
+		mu.Unlock()
+		for _, inner := range inners {
+			stop(inner)
+		}
+	}
+	onStop(out, func() {
+		stopInners(stopUpstream)
+	})
+
+	go func() {
+		var wg sync.WaitGroup
+		var once sync.Once
+		quit := make(chan struct{})
+		// Items are sent under a read lock of gate, which fail takes once quit
+		// is closed, so that no item can follow the error.
+		var gate sync.RWMutex
+
+		emit := func(item interface{}) bool {
+			gate.RLock()
+			defer gate.RUnlock()
+			select {
+			case <-quit:
+				return false
+			default:
+			}
+			return trySend(out, item, quit)
+		}
+
+		fail := func(err error) {
+			once.Do(func() {
+				close(quit)
+				gate.Lock()
+				gate.Unlock()
+				cancelUpstream(o)
+				stopInners(cancelUpstream)
+				out <- err
+			})
+		}
+
+	OuterLoop:
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				fail(err)
+				break
+			}
+
+			limiter.acquire()
+			select {
+			case <-quit:
+				limiter.release()
+				break OuterLoop
+			default:
+			}
+
+			inner := apply(item)
+			mu.Lock()
+			if stopped {
+				mu.Unlock()
+				cancelUpstream(inner)
+				limiter.release()
+				break OuterLoop
+			}
+			live[inner] = struct{}{}
+			mu.Unlock()
+
+			wg.Add(1)
+			go func(inner Observable) {
+				defer wg.Done()
+				defer limiter.release()
+				defer func() {
+					mu.Lock()
+					delete(live, inner)
+					mu.Unlock()
+				}()
+				for item := range inner {
+					if err, isErr := item.(error); isErr {
+						fail(err)
+						return
+					}
+					if !emit(item) {
+						cancelUpstream(inner)
+						return
+					}
+				}
+			}(inner)
+		}
+
+		wg.Wait()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
@@ -0,0 +1,131 @@
+package observable
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterSetLimit(t *testing.T) {
+	limiter := NewLimiter(0)
+	assert.Equal(t, 1, limiter.Limit())
+
+	limiter.SetLimit(4)
+	assert.Equal(t, 4, limiter.Limit())
+	assert.Equal(t, 0, limiter.Active())
+}
+
+func TestObservableMapConcurrentWithAdjustedLimit(t *testing.T) {
+	limiter := NewLimiter(1)
+	running := make(chan struct{}, 4)
+	release := make(chan struct{})
+
+	apply := func(item interface{}) interface{} {
+		running <- struct{}{}
+		<-release
+		return item.(int) * 10
+	}
+
+	myStream := Range(0, 4).MapConcurrent(apply, limiter)
+	nums := make(chan []int)
+	go func() {
+		collected := []int{}
+		for item := range myStream {
+			collected = append(collected, item.(int))
+		}
+		nums <- collected
+	}()
+
+	<-running
+	select {
+	case <-running:
+		assert.Fail(t, "second worker started beyond the limit")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	limiter.SetLimit(3)
+	<-running
+	<-running
+	assert.Equal(t, 3, limiter.Active())
+
+	close(release)
+	result := <-nums
+	sort.Ints(result)
+	assert.Exactly(t, []int{0, 10, 20, 30}, result)
+}
+
+func TestObservableFlatMap(t *testing.T) {
+	expand := func(item interface{}) Observable {
+		return Just(item, item.(int)*10)
+	}
+
+	nums := []int{}
+	for item := range Just(1, 2, 3).FlatMap(expand, 2) {
+		nums = append(nums, item.(int))
+	}
+	sort.Ints(nums)
+	assert.Exactly(t, []int{1, 2, 3, 10, 20, 30}, nums)
+}
+
+func TestObservableFlatMapWithError(t *testing.T) {
+	expand := func(item interface{}) Observable {
+		if item.(int) == 2 {
+			return Just(errors.New("bang"))
+		}
+		return Just(item)
+	}
+
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just(1, 2, 3).FlatMap(expand, 0).Subscribe(onError)
+	<-sub
+
+	assert.EqualError(t, myerr, "bang")
+}
+
+func TestObservableFlatMapErrorIsLast(t *testing.T) {
+	myerr := errors.New("bang")
+	ticking := Interval(nil, time.Millisecond)
+	failing := make(chan interface{})
+	go func() {
+		<-time.After(5 * time.Millisecond)
+		failing <- myerr
+		close(failing)
+	}()
+
+	expand := func(item interface{}) Observable {
+		if item.(int) == 1 {
+			return ticking
+		}
+		return Observable(failing)
+	}
+
+	items := []interface{}{}
+	for item := range Just(1, 2).FlatMap(expand, 0) {
+		items = append(items, item)
+	}
+
+	if assert.True(t, len(items) > 1) {
+		assert.Equal(t, myerr, items[len(items)-1])
+		for _, item := range items[:len(items)-1] {
+			assert.IsType(t, 0, item)
+		}
+	}
+	assert.True(t, waitClosed(ticking), "the live inner Observable is cancelled")
+}
+
+func TestObservableFlatMapDisposed(t *testing.T) {
+	assertStopped(t, "FlatMap", func() Observable {
+		return Just(1, 2).FlatMap(func(interface{}) Observable {
+			return Interval(nil, time.Millisecond)
+		}, 0)
+	})
+}
@@ -0,0 +1,117 @@
+package observable
+
+import (
+	"sync"
+
+	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// Loop queues notifications to be delivered on the goroutine of a host loop,
+// such as a game tick or a GUI main loop, which calls Drain once per frame.
+type Loop struct {
+	mu    sync.Mutex
+	room  *sync.Cond
+	queue []func()
+	size  int
+}
+
+// NewLoop creates an empty Loop queueing up to size notifications. Once it is
+// full, the goroutines queueing notifications wait for the next Drain, so that
+// a stalled host loop slows the Observables down rather than piling up their
+// items. A size of zero or less leaves the queue unbounded.
+func NewLoop(size int) *Loop {
+	l := &Loop{size: size}
+	l.room = sync.NewCond(&l.mu)
+	return l
+}
+
+// Drain delivers every notification queued so far on the calling goroutine
+// and returns their number. Notifications queued while draining are left for
+// the next call. A panicking notification is passed to the panic handler, and
+// does not prevent the next ones from being delivered.
+func (l *Loop) Drain() int {
+	l.mu.Lock()
+	queue := l.queue
+	l.queue = nil
+	l.room.Broadcast()
+	l.mu.Unlock()
+
+	for _, fn := range queue {
+		handlePanic(recovered(fn))
+	}
+	return len(queue)
+}
+
+func (l *Loop) schedule(fn func()) {
+	l.mu.Lock()
+	for l.size > 0 && len(l.queue) >= l.size {
+		l.room.Wait()
+	}
+	l.queue = append(l.queue, fn)
+	l.mu.Unlock()
+}
+
+// ObserveOnLoop subscribes an EventHandler whose handlers are only ever
+// called from the Loop's Drain, and returns a Subscription channel which
+// receives once the terminal notification has been delivered. A panic of the
+// NextHandler is passed to the ErrHandler and terminates the subscription.
+func (o Observable) ObserveOnLoop(l *Loop, handler rx.EventHandler) <-chan subscription.Subscription {
+	done := make(chan subscription.Subscription, 1)
+	sub := subscription.New().Subscribe()
+	ob := CheckEventHandler(handler)
+
+	// ended is closed by the task of a panicking NextHandler, so that the
+	// tasks queued after it are skipped.
+	ended := make(chan struct{})
+	isEnded := func() bool {
+		select {
+		case <-ended:
+			return true
+		default:
+			return false
+		}
+	}
+
+	go func() {
+		for item := range o {
+			if isEnded() {
+				cancelUpstream(o)
+				return
+			}
+
+			if err, isErr := item.(error); isErr {
+				l.schedule(func() {
+					if isEnded() {
+						return
+					}
+					onError(ob, err)
+					sub.Error = err
+					done <- sub.Unsubscribe()
+				})
+				return
+			}
+
+			item := item
+			l.schedule(func() {
+				if isEnded() {
+					return
+				}
+				if err := onNext(ob, item); err != nil {
+					sub.Error = err
+					close(ended)
+					done <- sub.Unsubscribe()
+				}
+			})
+		}
+
+		l.schedule(func() {
+			if isEnded() {
+				return
+			}
+			sub.Error = onDone(ob)
+			done <- sub.Unsubscribe()
+		})
+	}()
+	return done
+}
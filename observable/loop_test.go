@@ -0,0 +1,135 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservableObserveOnLoop(t *testing.T) {
+	loop := NewLoop(0)
+	source := make(chan interface{})
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onDone := handlers.DoneFunc(func() {
+		nums = append(nums, 1000)
+	})
+
+	sub := Observable(source).ObserveOnLoop(loop, observer.New(onNext, onDone))
+	source <- 1
+	source <- 2
+	assert.Empty(t, nums)
+
+	// Items are only delivered by the host loop.
+	for len(nums) < 2 {
+		loop.Drain()
+	}
+	assert.Exactly(t, []int{1, 2}, nums)
+
+	close(source)
+	for len(nums) < 3 {
+		loop.Drain()
+	}
+	assert.Exactly(t, []int{1, 2, 1000}, nums)
+	assert.NoError(t, (<-sub).Err())
+	assert.Equal(t, 0, loop.Drain())
+}
+
+func TestObservableObserveOnLoopWithError(t *testing.T) {
+	loop := NewLoop(0)
+
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just(1, errors.New("bang"), 2).ObserveOnLoop(loop, onError)
+	for myerr == nil {
+		loop.Drain()
+	}
+	assert.EqualError(t, (<-sub).Err(), "bang")
+}
+
+func TestLoopBounded(t *testing.T) {
+	loop := NewLoop(2)
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	emitted := make(chan interface{}, 10)
+	sub := Just(1, 2, 3, 4, 5).
+		Do(func(item interface{}) {
+			emitted <- item
+		}, nil, nil).
+		ObserveOnLoop(loop, onNext)
+
+	// The third item waits for room in the queue, and the fourth for the
+	// third to be queued.
+	for len(emitted) < 4 {
+		<-time.After(time.Millisecond)
+	}
+	<-time.After(10 * time.Millisecond)
+	assert.Len(t, emitted, 4)
+
+	for len(sub) == 0 {
+		loop.Drain()
+	}
+	assert.Exactly(t, []int{1, 2, 3, 4, 5}, nums)
+	assert.NoError(t, (<-sub).Err())
+}
+
+func TestObservableObserveOnLoopWithPanic(t *testing.T) {
+	loop := NewLoop(0)
+
+	var myerr error
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		if item.(int) == 2 {
+			panic("bang")
+		}
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	// Both the panicking task and the ones after it are handled by Drain.
+	sub := Just(1, 2, 3).ObserveOnLoop(loop, observer.New(onNext, onError))
+	for myerr == nil {
+		loop.Drain()
+	}
+	assert.Exactly(t, []int{1}, nums)
+	assert.True(t, errors.Is(myerr, rxerrors.New(rxerrors.HandlerError)), myerr)
+	assert.Equal(t, myerr, (<-sub).Err())
+	loop.Drain()
+	assert.Exactly(t, []int{1}, nums)
+
+	// A panic in a task does not drop the next ones.
+	panicked := make(chan error, 1)
+	SetPanicHandler(func(err error) {
+		panicked <- err
+	})
+	defer SetPanicHandler(nil)
+
+	ran := false
+	loop.schedule(func() {
+		panic("bang")
+	})
+	loop.schedule(func() {
+		ran = true
+	})
+	assert.Equal(t, 2, loop.Drain())
+	assert.True(t, ran)
+	assert.Error(t, <-panicked)
+}
@@ -1,7 +1,9 @@
 package observable
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/reactivex/rxgo"
@@ -22,20 +24,10 @@ func New(buffer uint) Observable {
 	return make(Observable, int(buffer))
 }
 
-// CheckHandler checks the underlying type of an EventHandler.
+// CheckHandler checks the underlying type of an EventHandler, and returns the
+// Observer it stands for, which drops any call made once it has terminated.
 func CheckEventHandler(handler rx.EventHandler) observer.Observer {
-	ob := observer.DefaultObserver
-	switch handler := handler.(type) {
-	case handlers.NextFunc:
-		ob.NextHandler = handler
-	case handlers.ErrFunc:
-		ob.ErrHandler = handler
-	case handlers.DoneFunc:
-		ob.DoneHandler = handler
-	case observer.Observer:
-		ob = handler
-	}
-	return ob
+	return observer.New(handler)
 }
 
 // Next returns the next item on the Observable.
@@ -47,36 +39,131 @@ func (o Observable) Next() (interface{}, error) {
 }
 
 // Subscribe subscribes an EventHandler and returns a Subscription channel.
+// A panic of a handler is recovered, see SetPanicHandler.
 func (o Observable) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	_, done := o.SubscribeDisposable(handler)
+	return done
+}
+
+// SubscribeNext subscribes a func handling the items of the Observable.
+func (o Observable) SubscribeNext(fn func(interface{})) <-chan subscription.Subscription {
+	return o.Subscribe(handlers.NextFunc(fn))
+}
+
+// SubscribeError subscribes a func handling the error of the Observable.
+func (o Observable) SubscribeError(fn func(error)) <-chan subscription.Subscription {
+	return o.Subscribe(handlers.ErrFunc(fn))
+}
+
+// SubscribeComplete subscribes a func handling the completion of the
+// Observable.
+func (o Observable) SubscribeComplete(fn func()) <-chan subscription.Subscription {
+	return o.Subscribe(handlers.DoneFunc(fn))
+}
+
+// SubscribeFunc subscribes funcs handling the items, the error and the
+// completion of the Observable, any of which may be nil.
+func (o Observable) SubscribeFunc(next func(interface{}), err func(error), done func()) <-chan subscription.Subscription {
+	return o.Subscribe(observer.New(observer.WithNextHandler(next), observer.WithErrHandler(err), observer.WithDoneHandler(done)))
+}
+
+// SubscribeDisposable is like Subscribe but also returns the Subscription
+// right away. Disposing of it stops reading the Observable, after which the
+// Subscription channel receives without OnDone being called. It also stops
+// the producers feeding the Observable, such as Interval or FromChannel,
+// along with the operators in between.
+func (o Observable) SubscribeDisposable(handler rx.EventHandler) (subscription.Subscription, <-chan subscription.Subscription) {
 	done := make(chan subscription.Subscription)
 	sub := subscription.New().Subscribe()
+	// The copy handed back shares the terminator, not the fields written
+	// by the reading goroutine.
+	handle := sub
 
 	ob := CheckEventHandler(handler)
 
 	go func() {
+		disposed := false
 	OuterLoop:
-		for item := range o {
-			switch item := item.(type) {
-			case error:
-				ob.OnError(item)
-
-				// Record the error and break the loop.
-				sub.Error = item
+		for {
+			select {
+			case <-sub.Terminated():
+				disposed = true
+				cancelUpstream(o)
 				break OuterLoop
-			default:
-				ob.OnNext(item)
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				switch item := item.(type) {
+				case error:
+					onError(ob, item)
+
+					// Record the error and break the loop.
+					sub.Error = item
+					break OuterLoop
+				default:
+					// A panicking handler terminates the subscription.
+					if err := onNext(ob, item); err != nil {
+						sub.Error = err
+						cancelUpstream(o)
+						break OuterLoop
+					}
+				}
 			}
 		}
 
 		// OnDone only gets executed if there's no error.
-		if sub.Error == nil {
-			ob.OnDone()
+		if sub.Error == nil && !disposed {
+			sub.Error = onDone(ob)
 		}
 
 		done <- sub.Unsubscribe()
 		return
 	}()
 
+	return handle, done
+}
+
+// SubscribeWithContext subscribes an EventHandler like Subscribe, but stops
+// reading the Observable as soon as the context is done, along with the
+// producers feeding it, as if the Subscription were disposed of. The
+// Subscription then records the context error, and neither OnError nor
+// OnDone is called.
+func (o Observable) SubscribeWithContext(ctx context.Context, handler rx.EventHandler) <-chan subscription.Subscription {
+	done := make(chan subscription.Subscription, 1)
+	sub := subscription.New().Subscribe()
+
+	ob := CheckEventHandler(handler)
+
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case <-ctx.Done():
+				sub.Error = ctx.Err()
+				cancelUpstream(o)
+				break OuterLoop
+			case item, ok := <-o:
+				if !ok {
+					sub.Error = onDone(ob)
+					break OuterLoop
+				}
+				if err, isErr := item.(error); isErr {
+					onError(ob, err)
+					sub.Error = err
+					break OuterLoop
+				}
+				if err := onNext(ob, item); err != nil {
+					sub.Error = err
+					cancelUpstream(o)
+					break OuterLoop
+				}
+			}
+		}
+
+		done <- sub.Unsubscribe()
+	}()
+
 	return done
 }
 
@@ -88,14 +175,20 @@ func (o Observable) Unsubscribe() subscription.Subscription {
 */
 
 // Map maps a MappableFunc predicate to each item in Observable and
-// returns a new Observable with applied items.
+// returns a new Observable with applied items. An error is passed on
+// unchanged and terminates the new Observable.
 func (o Observable) Map(apply fx.MappableFunc) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
 			out <- apply(item)
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -104,6 +197,7 @@ func (o Observable) Map(apply fx.MappableFunc) Observable {
 // a new Observable with the taken items.
 func (o Observable) Take(nth uint) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		takeCount := 0
 		for item := range o {
@@ -114,7 +208,7 @@ func (o Observable) Take(nth uint) Observable {
 			}
 			break
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -123,6 +217,7 @@ func (o Observable) Take(nth uint) Observable {
 // a new Observable with the taken items.
 func (o Observable) TakeLast(nth uint) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		buf := make([]interface{}, nth)
 		for item := range o {
@@ -134,49 +229,156 @@ func (o Observable) TakeLast(nth uint) Observable {
 		for _, takenItem := range buf {
 			out <- takenItem
 		}
-		close(out)
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// TakeWhile emits the items in the original Observable as long as they
+// satisfy a FilterableFunc predicate, and completes with the first item
+// which does not. An error is passed on and terminates the new Observable.
+func (o Observable) TakeWhile(apply fx.FilterableFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
+			if !apply(item) {
+				break
+			}
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// TakeUntil emits the items in the original Observable until another
+// Observable emits an item or an error, and then completes.
+func (o Observable) TakeUntil(other Observable) Observable {
+	out := make(chan interface{})
+	link(out, o, other)
+	go func() {
+		source := o
+	OuterLoop:
+		for {
+			select {
+			case _, ok := <-other:
+				if ok {
+					break OuterLoop
+				}
+				// A completed trigger never fires.
+				other = nil
+			case item, ok := <-source:
+				if !ok {
+					break OuterLoop
+				}
+				out <- item
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
 	}()
 	return Observable(out)
 }
 
 // Filter filters items in the original Observable and returns
-// a new Observable with the filtered items.
+// a new Observable with the filtered items. An error is passed on
+// unchanged and terminates the new Observable.
 func (o Observable) Filter(apply fx.FilterableFunc) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
 			if apply(item) {
 				out <- item
 			}
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
 
-// First returns new Observable which emit only first item.
+// First returns new Observable which emit only first item, or an error if
+// the original Observable completes without emitting any item.
 func (o Observable) First() Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
+		found := false
 		for item := range o {
 			out <- item
+			found = true
 			break
 		}
-		close(out)
+		if !found {
+			out <- errors.New(errors.NoSuchElementError, "observable is empty")
+		}
+		closeOut(out)
 	}()
 	return Observable(out)
 }
 
-// Last returns a new Observable which emit only last item.
+// Last returns a new Observable which emit only last item, or an error if
+// the original Observable completes without emitting any item.
+// An error is passed on and terminates the new Observable.
 func (o Observable) Last() Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		var last interface{}
+		found := false
 		for item := range o {
 			last = item
+			found = true
+			if _, isErr := item.(error); isErr {
+				break
+			}
+		}
+		if !found {
+			last = errors.New(errors.NoSuchElementError, "observable is empty")
 		}
 		out <- last
-		close(out)
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// ElementAt returns a new Observable which emits only the item at the given
+// index, or an error if the original Observable completes before reaching it.
+// An error is passed on and terminates the new Observable.
+func (o Observable) ElementAt(index uint) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var count uint
+		found := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				found = true
+				break
+			}
+			if count == index {
+				out <- item
+				found = true
+				break
+			}
+			count++
+		}
+		if !found {
+			out <- errors.New(errors.NoSuchElementError, "index out of range")
+		}
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -185,6 +387,7 @@ func (o Observable) Last() Observable {
 // a new Observable.
 func (o Observable) Distinct(apply fx.KeySelectorFunc) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		keysets := make(map[interface{}]struct{})
 		for item := range o {
@@ -195,7 +398,7 @@ func (o Observable) Distinct(apply fx.KeySelectorFunc) Observable {
 			}
 			keysets[key] = struct{}{}
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -204,6 +407,7 @@ func (o Observable) Distinct(apply fx.KeySelectorFunc) Observable {
 // Observable and returns a new Observable.
 func (o Observable) DistinctUntilChanged(apply fx.KeySelectorFunc) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		var current interface{}
 		for item := range o {
@@ -213,7 +417,7 @@ func (o Observable) DistinctUntilChanged(apply fx.KeySelectorFunc) Observable {
 				current = key
 			}
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -222,6 +426,7 @@ func (o Observable) DistinctUntilChanged(apply fx.KeySelectorFunc) Observable {
 // returns a new Observable with the rest items.
 func (o Observable) Skip(nth uint) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		skipCount := 0
 		for item := range o {
@@ -231,7 +436,107 @@ func (o Observable) Skip(nth uint) Observable {
 			}
 			out <- item
 		}
-		close(out)
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// SkipWhile suppresses the items in the original Observable as long as they
+// satisfy a FilterableFunc predicate, and emits every item from the first
+// one which does not. An error is passed on and terminates the new Observable.
+func (o Observable) SkipWhile(apply fx.FilterableFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		skipping := true
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
+			if skipping && apply(item) {
+				continue
+			}
+			skipping = false
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// SkipUntil suppresses the items in the original Observable until another
+// Observable emits an item or an error, and emits every item afterwards.
+// An error is passed on and terminates the new Observable.
+func (o Observable) SkipUntil(other Observable) Observable {
+	out := make(chan interface{})
+	link(out, o, other)
+	go func() {
+		skipping := true
+	OuterLoop:
+		for {
+			select {
+			case _, ok := <-other:
+				if ok {
+					skipping = false
+				}
+				other = nil
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					out <- item
+					break OuterLoop
+				}
+				if !skipping {
+					out <- item
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// WithLatestFrom emits, for each item in the original Observable, the result
+// of combining it with the latest item of another Observable. Items arriving
+// before the other Observable has emitted are dropped, and the other
+// Observable emitting does not emit on its own. An error from either of them
+// is passed on and terminates the new Observable.
+func (o Observable) WithLatestFrom(other Observable, combine fx.CombinableFunc) Observable {
+	out := make(chan interface{})
+	link(out, o, other)
+	go func() {
+		var latest interface{}
+		has := false
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-other:
+				if !ok {
+					other = nil
+					continue
+				}
+				if _, isErr := item.(error); isErr {
+					out <- item
+					break OuterLoop
+				}
+				latest, has = item, true
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					out <- item
+					break OuterLoop
+				}
+				if has {
+					out <- combine(item, latest)
+				}
+			}
+		}
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -240,6 +545,7 @@ func (o Observable) Skip(nth uint) Observable {
 // returns a new Observable with the rest items.
 func (o Observable) SkipLast(nth uint) Observable {
 	out := make(chan interface{})
+	link(out, o)
 	go func() {
 		buf := make(chan interface{}, nth)
 		for item := range o {
@@ -251,7 +557,7 @@ func (o Observable) SkipLast(nth uint) Observable {
 			}
 		}
 		close(buf)
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
@@ -259,32 +565,276 @@ func (o Observable) SkipLast(nth uint) Observable {
 
 // Scan applies ScannableFunc predicate to each item in the original
 // Observable sequentially and emits each successive value on a new Observable.
-func (o Observable) Scan(apply fx.ScannableFunc) Observable {
+// The accumulation starts from an optional seed, or nil. An error is passed
+// on unchanged and terminates the new Observable.
+func (o Observable) Scan(apply fx.ScannableFunc, seed ...interface{}) Observable {
 	out := make(chan interface{})
+	link(out, o)
 
 	go func() {
 		var current interface{}
+		if len(seed) > 0 {
+			current = seed[0]
+		}
 		for item := range o {
-			out <- apply(current, item)
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
 			current = apply(current, item)
+			out <- current
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Reduce applies ScannableFunc predicate to each item in the original
+// Observable sequentially and emits only the final accumulated value on a
+// new Observable once the original one completes. The accumulation starts
+// from an optional seed, or nil; without a seed, an empty Observable emits
+// nothing. An error is passed on instead of the accumulated value.
+func (o Observable) Reduce(apply fx.ScannableFunc, seed ...interface{}) Observable {
+	out := make(chan interface{})
+	link(out, o)
+
+	go func() {
+		var current interface{}
+		hasValue := len(seed) > 0
+		if hasValue {
+			current = seed[0]
+		}
+
+		var failure error
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				failure = err
+				break
+			}
+			current = apply(current, item)
+			hasValue = true
+		}
+
+		if failure != nil {
+			out <- failure
+		} else if hasValue {
+			out <- current
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Do calls the given handlers, any of which may be nil, for each item, the
+// error and the completion of the original Observable, and returns a new
+// Observable mirroring it unchanged. It is meant for side effects such as
+// logging or metrics.
+func (o Observable) Do(onNext handlers.NextFunc, onErr handlers.ErrFunc, onDone handlers.DoneFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		failed := false
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				if onErr != nil {
+					onErr(err)
+				}
+				out <- item
+				failed = true
+				break
+			}
+			if onNext != nil {
+				onNext(item)
+			}
+			out <- item
+		}
+		if !failed && onDone != nil {
+			onDone()
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// StartWith emits the given items before the items in the original
+// Observable.
+func (o Observable) StartWith(items ...interface{}) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		for _, item := range items {
+			out <- item
+		}
+		for item := range o {
+			out <- item
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// EndWith emits the given items after the items in the original Observable
+// once it completes. They are not emitted after an error.
+func (o Observable) EndWith(items ...interface{}) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		failed := false
+		for item := range o {
+			out <- item
+			if _, isErr := item.(error); isErr {
+				failed = true
+				break
+			}
+		}
+		if !failed {
+			for _, item := range items {
+				out <- item
+			}
 		}
-		close(out)
+		closeOut(out)
 	}()
 	return Observable(out)
 }
 
+// Valve opens and closes the flow of the original Observable according to the
+// values received on control, starting opened. While closed, up to bufferSize
+// items are buffered before the source stops being read; they are emitted
+// once the valve is opened again. Closing control leaves the valve opened.
+// Buffered items are held against the Budget.
+func (o Observable) Valve(control <-chan bool, bufferSize int) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	depth := queued(out)
+	go func() {
+		budget := CurrentBudget()
+		opened := true
+		source := o
+		buf := []interface{}{}
+		held := 0
+		for source != nil || len(buf) > 0 {
+			atomic.StoreInt64(depth, int64(len(buf)))
+			in := source
+			if len(buf) >= bufferSize {
+				in = nil
+			}
+
+			var send chan<- interface{}
+			var next interface{}
+			if opened && len(buf) > 0 {
+				send = out
+				next = buf[0]
+			}
+
+			select {
+			case open, ok := <-control:
+				if !ok {
+					control = nil
+					open = true
+				}
+				opened = open
+			case item, ok := <-in:
+				if !ok {
+					source = nil
+					continue
+				}
+				if !budget.Acquire() {
+					if err := budget.Shed(); err != nil {
+						buf = append(buf, err)
+						source = nil
+					}
+					continue
+				}
+				buf = append(buf, item)
+				held++
+			case send <- next:
+				buf = buf[1:]
+				// A shed error is always last and is not held.
+				if held > 0 {
+					held--
+					budget.Release(1)
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Heartbeat emits the result of an EmittableFunc whenever the original
+// Observable has been silent for the given duration, leaving its own items
+// untouched. It is useful to keep idle downstream connections alive.
+func (o Observable) Heartbeat(d time.Duration, beat fx.EmittableFunc) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				out <- item
+			case <-clock.After(d):
+				out <- beat()
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Unbounded returns a channel whose items are queued without bound and
+// emitted on the returned Observable, so that sending to it never waits for
+// a slow consumer. It is typically used for side outputs, which should not
+// stall the main stream. Closing the channel completes the Observable once
+// the queue is drained. Once the Observable is stopped, the queue is dropped
+// and the items sent are discarded.
+func Unbounded() (chan<- interface{}, Observable) {
+	return sideOutput()
+}
+
 // From creates a new Observable from an Iterator.
 func From(it rx.Iterator) Observable {
 	source := make(chan interface{})
+	quit := stoppable(source)
 	go func() {
 		for {
 			val, err := it.Next()
-			if err != nil {
+			if err != nil || !trySend(source, val, quit) {
 				break
 			}
-			source <- val
 		}
-		close(source)
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// FromChannel creates an Observable emitting every value received from an
+// existing channel until it is closed. Errors received are emitted as errors.
+// Once the Observable is stopped, such as by disposing of its Subscription,
+// the channel is no longer read.
+func FromChannel(ch <-chan interface{}) Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-ch:
+				if !ok || !trySend(source, item, quit) {
+					break OuterLoop
+				}
+			case <-quit:
+				break OuterLoop
+			}
+		}
+		closeOut(source)
 	}()
 	return Observable(source)
 }
@@ -298,74 +848,147 @@ func Empty() Observable {
 	return Observable(source)
 }
 
+// Never creates an Observable which emits no item and never terminates by
+// itself. It only completes once stopped, such as when a Subscription to it is
+// disposed of.
+func Never() Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+		<-quit
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// Throw creates an Observable which emits the given error and terminates
+// immediately.
+func Throw(err error) Observable {
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+		trySend(source, err, quit)
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// IntervalOption configures Interval.
+type IntervalOption func(*intervalConfig)
+
+type intervalConfig struct {
+	delay time.Duration
+	count int
+}
+
+// WithInitialDelay makes Interval emit its first integer after the given
+// delay rather than after one interval.
+func WithInitialDelay(d time.Duration) IntervalOption {
+	return func(c *intervalConfig) {
+		c.delay = d
+	}
+}
+
+// WithCount makes Interval complete once it has emitted n integers. A count
+// below one leaves it unlimited.
+func WithCount(n int) IntervalOption {
+	return func(c *intervalConfig) {
+		c.count = n
+	}
+}
+
 // Interval creates an Observable emitting incremental integers infinitely between
-// each given time interval.
-func Interval(term chan struct{}, interval time.Duration) Observable {
+// each given time interval, until term is closed, such as the Terminated channel
+// of a Subscription. Options can delay the first integer or limit their count.
+func Interval(term <-chan struct{}, interval time.Duration, opts ...IntervalOption) Observable {
+	c := intervalConfig{delay: interval}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return ticks(term, c.delay, interval, c.count)
+}
+
+// Timer creates an Observable emitting 0 once the given delay has elapsed. If
+// a period is given, it goes on emitting incremental integers infinitely
+// between each period, like Interval, until term is closed.
+func Timer(term <-chan struct{}, delay time.Duration, period ...time.Duration) Observable {
+	if len(period) == 0 {
+		return ticks(term, delay, 0, 1)
+	}
+	return ticks(term, delay, period[0], 0)
+}
+
+// ticks emits incremental integers, the first one after delay and the next
+// ones every period, until term is closed or, if count is positive, count
+// integers have been emitted.
+func ticks(term <-chan struct{}, delay, period time.Duration, count int) Observable {
 	source := make(chan interface{})
-	go func(term chan struct{}) {
+	quit := stoppable(source)
+	clock := CurrentClock()
+	go func() {
+		wait := clock.After(delay)
 		i := 0
 	OuterLoop:
 		for {
 			select {
 			case <-term:
 				break OuterLoop
-			case <-time.After(interval):
-				source <- i
+			case <-quit:
+				break OuterLoop
+			case <-wait:
+				// A consumer which has gone away must not keep the
+				// producer from terminating.
+				select {
+				case source <- i:
+				case <-term:
+					break OuterLoop
+				case <-quit:
+					break OuterLoop
+				}
 			}
 			i++
+			if count > 0 && i >= count {
+				break
+			}
+			wait = clock.After(period)
 		}
-		close(source)
-	}(term)
+		closeOut(source)
+	}()
 	return Observable(source)
 }
 
-// Repeat creates an Observable emitting a given item repeatedly
+// Repeat creates an Observable emitting a given item repeatedly, ntimes[0]
+// times if given and forever otherwise. To repeat a whole source, see
+// Factory.Repeat.
 func Repeat(item interface{}, ntimes ...int) Observable {
-	source := make(chan interface{})
-	
-	// this is the infinity case no ntime parameter is given
-	if len(ntimes) == 0 {
-		go func() {
-			for {
-				source <- item
-			}
-			close(source)
-		}()
-		return Observable(source)
-	}
-
-	// this repeat the item ntime
+	count := -1
 	if len(ntimes) > 0 {
-		count := ntimes[0]
+		count = ntimes[0]
 		if count <= 0 {
 			return Empty()
 		}
-		go func() {
-			for i := 0; i < count; i++ {
-				source <- item
-			}
-			close(source)
-		}()
-		return Observable(source)
 	}
 
-	return Empty()
-}
-
-// Range creates an Observable that emits a particular range of sequential integers.
-func Range(start, end int) Observable {
 	source := make(chan interface{})
+	quit := stoppable(source)
 	go func() {
-		i := start
-		for i < end {
-			source <- i
-			i++
+		for i := 0; count < 0 || i < count; i++ {
+			if !trySend(source, item, quit) {
+				break
+			}
 		}
-		close(source)
+		closeOut(source)
 	}()
 	return Observable(source)
 }
 
+// Range creates an Observable that emits a particular range of sequential
+// integers, from start included to end excluded. See RangeStep for other steps
+// and for including the end.
+func Range(start, end int) Observable {
+	return RangeStep(start, end, 1)
+}
+
 // Just creates an Observable with the provided item(s).
 func Just(item interface{}, items ...interface{}) Observable {
 	source := make(chan interface{})
@@ -375,16 +998,289 @@ func Just(item interface{}, items ...interface{}) Observable {
 		items = []interface{}{item}
 	}
 
+	quit := stoppable(source)
 	go func() {
 		for _, item := range items {
-			source <- item
+			if !trySend(source, item, quit) {
+				break
+			}
 		}
-		close(source)
+		closeOut(source)
 	}()
 
 	return Observable(source)
 }
 
+// Concat creates an Observable emitting the items of every source in order,
+// moving on to the next source only once the previous one has completed.
+// An error is passed on and terminates the Observable.
+func Concat(sources ...Observable) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+	go func() {
+	OuterLoop:
+		for _, source := range sources {
+			for item := range source {
+				out <- item
+				if _, isErr := item.(error); isErr {
+					break OuterLoop
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// ConcatDelayError is like Concat, but a source emitting an error is followed
+// by the next one all the same. The errors are emitted once every source has
+// terminated, as is if there is one, and gathered in a CompositeError
+// otherwise.
+func ConcatDelayError(sources ...Observable) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+	go func() {
+		var errs []error
+		for _, source := range sources {
+			for item := range source {
+				if err, isErr := item.(error); isErr {
+					errs = append(errs, err)
+					break
+				}
+				out <- item
+			}
+		}
+		if len(errs) > 0 {
+			out <- composeErrors(errs)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Merge creates an Observable interleaving the items of every source, which
+// completes once all of them have completed. The first error emitted by any
+// source is passed on as the last item, and stops the other sources.
+func Merge(sources ...Observable) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+	go func() {
+		var wg sync.WaitGroup
+		// mu orders the sends with the error, after which nothing is
+		// forwarded anymore.
+		var mu sync.Mutex
+		failed := false
+
+		for _, source := range sources {
+			wg.Add(1)
+			go func(source Observable) {
+				defer wg.Done()
+				for item := range source {
+					_, isErr := item.(error)
+					mu.Lock()
+					if failed {
+						// Drain the source until it is stopped.
+						mu.Unlock()
+						continue
+					}
+					out <- item
+					failed = isErr
+					mu.Unlock()
+
+					if isErr {
+						for _, source := range sources {
+							stopUpstream(source)
+						}
+					}
+				}
+			}(source)
+		}
+
+		wg.Wait()
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// MergeDelayError is like Merge, but a source emitting an error does not stop
+// the other sources from being merged. The errors are emitted once every
+// source has terminated, as is if there is one, and gathered in a
+// CompositeError otherwise.
+func MergeDelayError(sources ...Observable) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+	go func() {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var errs []error
+
+		for _, source := range sources {
+			wg.Add(1)
+			go func(source Observable) {
+				defer wg.Done()
+				for item := range source {
+					if err, isErr := item.(error); isErr {
+						mu.Lock()
+						errs = append(errs, err)
+						mu.Unlock()
+						return
+					}
+					out <- item
+				}
+			}(source)
+		}
+
+		wg.Wait()
+		if len(errs) > 0 {
+			out <- composeErrors(errs)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Amb creates an Observable mirroring only the first source to emit an item,
+// an error or its completion, and stops consuming every other source. This
+// suits racing redundant replicas of the same call.
+func Amb(sources ...Observable) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+	go func() {
+		type first struct {
+			source Observable
+			item   interface{}
+			ok     bool
+		}
+		firsts := make(chan first, len(sources))
+		for _, source := range sources {
+			go func(source Observable) {
+				item, ok := <-source
+				firsts <- first{source, item, ok}
+			}(source)
+		}
+
+		if len(sources) > 0 {
+			winner := <-firsts
+			for _, source := range sources {
+				if source != winner.source {
+					cancelUpstream(source)
+				}
+			}
+			if winner.ok {
+				out <- winner.item
+				if _, isErr := winner.item.(error); !isErr {
+					for item := range winner.source {
+						out <- item
+						if _, isErr := item.(error); isErr {
+							break
+						}
+					}
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// CombineLatest creates an Observable which, whenever any source emits,
+// emits the result of combining the latest item of every source, once each
+// of them has emitted at least one. It completes once all sources have
+// completed. The first error emitted by any source is passed on and
+// terminates the Observable.
+func CombineLatest(sources []Observable, combine fx.AggregateFunc) Observable {
+	return combineLatest(sources, combine, false)
+}
+
+// CombineLatestDelayError is like CombineLatest, but a source emitting an
+// error only stops contributing to the combinations, its latest item being
+// kept. The errors are emitted once every source has terminated, as is if
+// there is one, and gathered in a CompositeError otherwise.
+func CombineLatestDelayError(sources []Observable, combine fx.AggregateFunc) Observable {
+	return combineLatest(sources, combine, true)
+}
+
+func combineLatest(sources []Observable, combine fx.AggregateFunc, delayError bool) Observable {
+	out := make(chan interface{})
+	link(out, sources...)
+
+	type indexed struct {
+		index int
+		item  interface{}
+	}
+
+	go func() {
+		items := make(chan indexed)
+		quit := make(chan struct{})
+		var wg sync.WaitGroup
+
+		for i, source := range sources {
+			wg.Add(1)
+			go func(i int, source Observable) {
+				defer wg.Done()
+				for item := range source {
+					select {
+					case items <- indexed{i, item}:
+					case <-quit:
+						return
+					}
+					if _, isErr := item.(error); isErr {
+						return
+					}
+				}
+			}(i, source)
+		}
+
+		go func() {
+			wg.Wait()
+			close(items)
+		}()
+
+		latest := make([]interface{}, len(sources))
+		has := make([]bool, len(sources))
+		missing := len(sources)
+		var errs []error
+
+		for next := range items {
+			if err, isErr := next.item.(error); isErr {
+				if delayError {
+					errs = append(errs, err)
+					continue
+				}
+				out <- err
+				close(quit)
+				break
+			}
+
+			if !has[next.index] {
+				has[next.index] = true
+				missing--
+			}
+			latest[next.index] = next.item
+
+			if missing == 0 {
+				snapshot := make([]interface{}, len(latest))
+				copy(snapshot, latest)
+				out <- combine(snapshot)
+			}
+		}
+		if len(errs) > 0 {
+			out <- composeErrors(errs)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// composeErrors returns the only error given, or a CompositeError gathering
+// all of them.
+func composeErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Composite(errs...)
+}
+
 // Start creates an Observable from one or more directive-like EmittableFunc
 // and emits the result of each operation asynchronously on a new Observable.
 func Start(f fx.EmittableFunc, fs ...fx.EmittableFunc) Observable {
@@ -395,12 +1291,13 @@ func Start(f fx.EmittableFunc, fs ...fx.EmittableFunc) Observable {
 	}
 
 	source := make(chan interface{})
+	quit := stoppable(source)
 
 	var wg sync.WaitGroup
 	for _, f := range fs {
 		wg.Add(1)
 		go func(f fx.EmittableFunc) {
-			source <- f()
+			trySend(source, f(), quit)
 			wg.Done()
 		}(f)
 	}
@@ -408,7 +1305,7 @@ func Start(f fx.EmittableFunc, fs ...fx.EmittableFunc) Observable {
 	// Wait in another goroutine to not block
 	go func() {
 		wg.Wait()
-		close(source)
+		closeOut(source)
 	}()
 
 	return Observable(source)
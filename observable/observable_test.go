@@ -1,15 +1,20 @@
 package observable
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 	"testing"
 	"time"
 
+	rxerrors "github.com/reactivex/rxgo/errors"
 	"github.com/reactivex/rxgo/fx"
 	"github.com/reactivex/rxgo/handlers"
 	"github.com/reactivex/rxgo/iterable"
 	"github.com/reactivex/rxgo/observer"
+	"github.com/reactivex/rxgo/subscription"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,6 +59,18 @@ func TestCheckEventHandler(t *testing.T) {
 	assert.Equal(t, "donedone", testtext)
 }
 
+func TestFromChannel(t *testing.T) {
+	ch := make(chan interface{}, 3)
+	ch <- 1
+	ch <- 2
+	ch <- errors.New("bang")
+	close(ch)
+
+	items, err := FromChannel(ch).ToSlice()
+	assert.Exactly(t, []interface{}{1, 2}, items)
+	assert.EqualError(t, err, "bang")
+}
+
 func TestEmptyOperator(t *testing.T) {
 	myStream := Empty()
 	text := ""
@@ -67,6 +84,59 @@ func TestEmptyOperator(t *testing.T) {
 	assert.Equal(t, "done", text)
 }
 
+func TestNeverOperator(t *testing.T) {
+	select {
+	case <-Never():
+		t.Fatal("Never emitted or terminated")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestThrowOperator(t *testing.T) {
+	myerr := errors.New("bang")
+	myStream := Throw(myerr)
+	var err error
+
+	onError := handlers.ErrFunc(func(e error) {
+		err = e
+	})
+	sub := myStream.Subscribe(onError)
+	<-sub
+
+	assert.Equal(t, myerr, err)
+}
+
+func TestSubscribeFuncs(t *testing.T) {
+	sum := 0
+	<-Just(1, 2).SubscribeNext(func(item interface{}) {
+		sum += item.(int)
+	})
+	assert.Equal(t, 3, sum)
+
+	myerr := errors.New("bang")
+	var err error
+	<-Throw(myerr).SubscribeError(func(e error) {
+		err = e
+	})
+	assert.Equal(t, myerr, err)
+
+	done := false
+	<-Just(1).SubscribeComplete(func() {
+		done = true
+	})
+	assert.True(t, done)
+
+	sum, done = 0, false
+	sub := <-Just(1, 2).SubscribeFunc(func(item interface{}) {
+		sum += item.(int)
+	}, nil, func() {
+		done = true
+	})
+	assert.Equal(t, 3, sum)
+	assert.True(t, done)
+	assert.NoError(t, sub.Err())
+}
+
 func TestIntervalOperator(t *testing.T) {
 	fin := make(chan struct{})
 	myStream := Interval(fin, 10*time.Millisecond)
@@ -362,6 +432,113 @@ func TestSubscribeToObserver(t *testing.T) {
 	assert.Equal("bang", sub.Err().Error())
 }
 
+func TestSubscribeDisposable(t *testing.T) {
+	nums := []int{}
+	done := false
+	ob := observer.New(
+		handlers.NextFunc(func(item interface{}) {
+			nums = append(nums, item.(int))
+		}),
+		handlers.DoneFunc(func() {
+			done = true
+		}),
+	)
+
+	// The Interval producer completes once the Subscription is disposed of.
+	stopped := make(chan struct{})
+	source := Interval(nil, time.Millisecond).Do(nil, nil, func() {
+		close(stopped)
+	})
+
+	sub, subs := source.SubscribeDisposable(ob)
+	<-time.After(10 * time.Millisecond)
+	sub.Dispose()
+
+	<-subs
+	assert.NotEmpty(t, nums)
+	assert.False(t, done)
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "producer still running after Dispose")
+	}
+}
+
+func TestIntervalTerminatedBySubscription(t *testing.T) {
+	sub := subscription.New().Subscribe()
+	myStream := Interval(sub.Terminated(), time.Millisecond)
+	assert.Equal(t, 0, <-myStream)
+
+	// The producer stops even though nobody reads it anymore.
+	sub.Dispose()
+	<-time.After(10 * time.Millisecond)
+	count := 0
+	for range myStream {
+		count++
+	}
+	assert.True(t, count <= 1, count)
+}
+
+func TestSubscribeWithContext(t *testing.T) {
+	nums := []int{}
+	done := false
+	newObserver := func() observer.Observer {
+		return observer.New(
+			handlers.NextFunc(func(item interface{}) {
+				nums = append(nums, item.(int))
+			}),
+			handlers.DoneFunc(func() {
+				done = true
+			}),
+		)
+	}
+
+	sub := <-Just(1, 2, 3).SubscribeWithContext(context.Background(), newObserver())
+	assert.Nil(t, sub.Err())
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+	assert.True(t, done)
+
+	source := make(chan interface{})
+	ctx, cancel := context.WithCancel(context.Background())
+	nums, done = []int{}, false
+	// An Observer which has terminated drops any further item.
+	subs := Observable(source).SubscribeWithContext(ctx, newObserver())
+	source <- 4
+	cancel()
+
+	sub = <-subs
+	assert.Equal(t, context.Canceled, sub.Err())
+	assert.Exactly(t, []int{4}, nums)
+	assert.False(t, done)
+
+	// The upstream goroutine is not left blocked sending.
+	sent := make(chan struct{})
+	go func() {
+		source <- 5
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		assert.Fail(t, "upstream blocked after the context is done")
+	}
+
+	// Producers are stopped.
+	stopped := make(chan struct{})
+	ctx, cancel = context.WithCancel(context.Background())
+	subs = Interval(nil, time.Millisecond).Do(nil, nil, func() {
+		close(stopped)
+	}).SubscribeWithContext(ctx, handlers.NextFunc(func(interface{}) {}))
+	cancel()
+	<-subs
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "producer still running after the context is done")
+	}
+}
+
 func TestObservableMap(t *testing.T) {
 	items := []interface{}{1, 2, 3, "foo", "bar", []byte("baz")}
 	it, err := iterable.New(items)
@@ -394,6 +571,37 @@ func TestObservableMap(t *testing.T) {
 	assert.Exactly(t, []int{10, 20, 30}, nums)
 }
 
+func TestObservableMapWithError(t *testing.T) {
+	applied := []interface{}{}
+	double := func(item interface{}) interface{} {
+		applied = append(applied, item)
+		return item.(int) * 2
+	}
+
+	nums := []int{}
+	var myerr error
+	done := false
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+	onDone := handlers.DoneFunc(func() {
+		done = true
+	})
+
+	source := Just(1, 2, errors.New("bang"), 3)
+	sub := source.Map(double).Subscribe(observer.New(onNext, onError, onDone))
+	<-sub
+
+	assert.Exactly(t, []int{2, 4}, nums)
+	assert.Exactly(t, []interface{}{1, 2}, applied)
+	assert.EqualError(t, myerr, "bang")
+	assert.False(t, done)
+}
+
 func TestObservableTake(t *testing.T) {
 	items := []interface{}{1, 2, 3, 4, 5}
 	it, err := iterable.New(items)
@@ -475,6 +683,49 @@ func TestObservableTakeLastWithEmpty(t *testing.T) {
 	assert.Exactly(t, []int{}, nums)
 }*/
 
+func TestObservableTakeWhile(t *testing.T) {
+	lessThan4 := func(item interface{}) bool {
+		return item.(int) < 4
+	}
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	sub := Just(1, 2, 3, 4, 1).TakeWhile(lessThan4).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+}
+
+func TestObservableTakeUntil(t *testing.T) {
+	source := make(chan interface{})
+	trigger := make(chan interface{})
+	myStream := Observable(source).TakeUntil(trigger)
+
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+	source <- 2
+	assert.Equal(t, 2, <-myStream)
+
+	trigger <- struct{}{}
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestObservableTakeUntilWithCompletedTrigger(t *testing.T) {
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	sub := Range(1, 4).TakeUntil(Empty()).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+}
+
 func TestObservableFilter(t *testing.T) {
 	items := []interface{}{1, 2, 3, 120, []byte("baz"), 7, 10, 13}
 	it, err := iterable.New(items)
@@ -510,6 +761,29 @@ func TestObservableFilter(t *testing.T) {
 	assert.Exactly(t, []int{1, 2, 3, 7}, nums)
 }
 
+func TestObservableFilterWithError(t *testing.T) {
+	isEven := func(item interface{}) bool {
+		return item.(int)%2 == 0
+	}
+
+	nums := []int{}
+	var myerr error
+
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	source := Just(1, 2, 3, 4, errors.New("bang"), 6)
+	sub := source.Filter(isEven).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []int{2, 4}, nums)
+	assert.EqualError(t, myerr, "bang")
+}
+
 func TestObservableFirst(t *testing.T) {
 	items := []interface{}{0, 1, 3}
 	it, err := iterable.New(items)
@@ -545,10 +819,16 @@ func TestObservableFirstWithEmpty(t *testing.T) {
 		}
 	})
 
-	sub := stream2.Subscribe(onNext)
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := stream2.Subscribe(observer.New(onNext, onError))
 	<-sub
 
 	assert.Exactly(t, []int{}, nums)
+	assert.Error(t, myerr)
 }
 
 func TestObservableLast(t *testing.T) {
@@ -587,10 +867,40 @@ func TestObservableLastWithEmpty(t *testing.T) {
 		}
 	})
 
-	sub := stream2.Subscribe(onNext)
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := stream2.Subscribe(observer.New(onNext, onError))
 	<-sub
 
 	assert.Exactly(t, []int{}, nums)
+	assert.Error(t, myerr)
+}
+
+func TestObservableLastWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	items := []interface{}{}
+	for item := range Just(1, myerr, 2).Last() {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{myerr}, items)
+}
+
+func TestObservableElementAt(t *testing.T) {
+	items := []interface{}{}
+	for item := range Just(0, 1, 3, 5).ElementAt(2) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{3}, items)
+
+	items = []interface{}{}
+	for item := range Just(0, 1).ElementAt(2) {
+		items = append(items, item)
+	}
+	assert.Len(t, items, 1)
+	assert.Error(t, items[0].(error))
 }
 
 func TestObservableSkip(t *testing.T) {
@@ -635,6 +945,64 @@ func TestObservableSkipWithEmpty(t *testing.T) {
 	assert.Exactly(t, []int{}, nums)	
 }
 
+func TestObservableSkipWhile(t *testing.T) {
+	lessThan4 := func(item interface{}) bool {
+		return item.(int) < 4
+	}
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	sub := Just(1, 2, 4, 1, 5).SkipWhile(lessThan4).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []int{4, 1, 5}, nums)
+}
+
+func TestObservableSkipUntil(t *testing.T) {
+	source := make(chan interface{})
+	trigger := make(chan interface{})
+	myStream := Observable(source).SkipUntil(trigger)
+
+	source <- 1
+	trigger <- struct{}{}
+	source <- 2
+	assert.Equal(t, 2, <-myStream)
+	source <- 3
+	assert.Equal(t, 3, <-myStream)
+
+	close(source)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestObservableWithLatestFrom(t *testing.T) {
+	source := make(chan interface{})
+	other := make(chan interface{})
+	myStream := Observable(source).WithLatestFrom(other, func(a, b interface{}) interface{} {
+		return fmt.Sprintf("%v%v", a, b)
+	})
+
+	source <- 1
+	other <- "a"
+	other <- "b"
+	source <- 2
+	assert.Equal(t, "2b", <-myStream)
+	close(other)
+	source <- 3
+	assert.Equal(t, "3b", <-myStream)
+
+	myerr := errors.New("bang")
+	other = make(chan interface{})
+	myStream = Never().WithLatestFrom(other, nil)
+	other <- myerr
+	assert.Exactly(t, []interface{}{myerr}, drain(myStream))
+
+	close(source)
+}
+
 func TestObservableSkipLast(t *testing.T) {
 	items := []interface{}{0, 1, 3, 5, 1, 8}
 	it, err := iterable.New(items)
@@ -814,6 +1182,84 @@ func TestObservableScanWithString(t *testing.T) {
 	assert.Exactly(t, expected, words)
 }
 
+func TestObservableScanWithSeed(t *testing.T) {
+	calls := 0
+	sum := func(acc, item interface{}) interface{} {
+		calls++
+		return acc.(int) + item.(int)
+	}
+
+	nums := []int{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	source := Just(1, 2, 3, errors.New("bang"), 4)
+	sub := source.Scan(sum, 100).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []int{101, 103, 106}, nums)
+	assert.Equal(t, 3, calls)
+	assert.EqualError(t, myerr, "bang")
+}
+
+func TestObservableReduce(t *testing.T) {
+	sum := func(acc, item interface{}) interface{} {
+		if acc == nil {
+			return item
+		}
+		return acc.(int) + item.(int)
+	}
+
+	tests := []struct {
+		source   Observable
+		seed     []interface{}
+		expected []interface{}
+	}{
+		{Just(1, 2, 3), nil, []interface{}{6}},
+		{Just(1, 2, 3), []interface{}{10}, []interface{}{16}},
+		{Empty(), nil, []interface{}{}},
+		{Empty(), []interface{}{10}, []interface{}{10}},
+	}
+
+	for _, tt := range tests {
+		items := []interface{}{}
+		onNext := handlers.NextFunc(func(item interface{}) {
+			items = append(items, item)
+		})
+
+		sub := tt.source.Reduce(sum, tt.seed...).Subscribe(onNext)
+		<-sub
+
+		assert.Exactly(t, tt.expected, items)
+	}
+}
+
+func TestObservableReduceWithError(t *testing.T) {
+	sum := func(acc, item interface{}) interface{} {
+		return acc.(int) + item.(int)
+	}
+
+	items := []interface{}{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		items = append(items, item)
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just(1, errors.New("bang")).Reduce(sum, 0).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Empty(t, items)
+	assert.EqualError(t, myerr, "bang")
+}
+
 func TestRepeatInfinityOperator(t *testing.T) {
 	myStream := Repeat("mystring")
 
@@ -909,3 +1355,322 @@ func TestRepeatWithNegativeTimesOperator(t *testing.T) {
 
 	assert.Exactly(t, []string{"end"}, stringarray)
 }
+
+func TestObservableDo(t *testing.T) {
+	seen := []interface{}{}
+	done := false
+	onNext := handlers.NextFunc(func(item interface{}) {
+		seen = append(seen, item)
+	})
+	onDone := handlers.DoneFunc(func() {
+		done = true
+	})
+
+	items := []interface{}{}
+	for item := range Just(1, 2).Do(onNext, nil, onDone) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, 2}, items)
+	assert.Exactly(t, []interface{}{1, 2}, seen)
+	assert.True(t, done)
+
+	var myerr error
+	done = false
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+	for range Just(1, errors.New("bang")).Do(nil, onError, onDone) {
+	}
+	assert.EqualError(t, myerr, "bang")
+	assert.False(t, done)
+}
+
+func TestObservableStartWith(t *testing.T) {
+	items := []interface{}{}
+	for item := range Just(3, 4).StartWith(1, 2) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, 2, 3, 4}, items)
+}
+
+func TestObservableEndWith(t *testing.T) {
+	items := []interface{}{}
+	for item := range Just(1, 2).EndWith(3, 4) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, 2, 3, 4}, items)
+
+	myerr := errors.New("bang")
+	items = []interface{}{}
+	for item := range Just(1, myerr).EndWith(3) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{1, myerr}, items)
+}
+
+func TestObservableValve(t *testing.T) {
+	source := make(chan interface{})
+	control := make(chan bool)
+	myStream := Observable(source).Valve(control, 2)
+
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+
+	control <- false
+	source <- 2
+	source <- 3
+
+	select {
+	case item := <-myStream:
+		assert.Fail(t, "valve emitted while closed", item)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	control <- true
+	close(source)
+
+	nums := []int{}
+	for item := range myStream {
+		nums = append(nums, item.(int))
+	}
+	assert.Exactly(t, []int{2, 3}, nums)
+}
+
+func TestObservableHeartbeat(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).Heartbeat(10*time.Millisecond, func() interface{} {
+		return "ping"
+	})
+
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+	assert.Equal(t, "ping", <-myStream)
+	assert.Equal(t, "ping", <-myStream)
+
+	go func() {
+		source <- 2
+		close(source)
+	}()
+
+	items := []interface{}{}
+	for item := range myStream {
+		if item != "ping" {
+			items = append(items, item)
+		}
+	}
+	assert.Exactly(t, []interface{}{2}, items)
+}
+
+func TestUnbounded(t *testing.T) {
+	in, myStream := Unbounded()
+	for i := 0; i < 100; i++ {
+		in <- i
+	}
+	close(in)
+
+	nums := []int{}
+	for item := range myStream {
+		nums = append(nums, item.(int))
+	}
+	assert.Len(t, nums, 100)
+	assert.Equal(t, 99, nums[99])
+}
+
+func TestMergeOperator(t *testing.T) {
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	done := false
+	onDone := handlers.DoneFunc(func() {
+		done = true
+	})
+
+	sub := Merge(Just(1, 2), Range(3, 6), Empty()).Subscribe(observer.New(onNext, onDone))
+	<-sub
+
+	sort.Ints(nums)
+	assert.Exactly(t, []int{1, 2, 3, 4, 5}, nums)
+	assert.True(t, done)
+}
+
+func TestMergeOperatorWithError(t *testing.T) {
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Merge(Interval(make(chan struct{}), time.Millisecond), Just(errors.New("bang"))).Subscribe(onError)
+	<-sub
+
+	assert.EqualError(t, myerr, "bang")
+}
+
+func TestMergeOperatorStopsAfterError(t *testing.T) {
+	// Nothing is emitted after the error, however busy the other sources.
+	for i := 0; i < 100; i++ {
+		items := drain(Merge(Repeat(1), Just(errors.New("bang")), Repeat(2)))
+		if assert.NotEmpty(t, items) {
+			last := items[len(items)-1]
+			assert.EqualError(t, last.(error), "bang")
+		}
+	}
+}
+
+func TestCombineLatestOperator(t *testing.T) {
+	left := make(chan interface{})
+	right := make(chan interface{})
+
+	sum := func(items []interface{}) interface{} {
+		return items[0].(int) + items[1].(int)
+	}
+
+	myStream := CombineLatest([]Observable{left, right}, sum)
+
+	left <- 1
+	right <- 10
+	assert.Equal(t, 11, <-myStream)
+
+	left <- 2
+	assert.Equal(t, 12, <-myStream)
+
+	right <- 20
+	assert.Equal(t, 22, <-myStream)
+
+	close(left)
+	close(right)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestCombineLatestOperatorWithError(t *testing.T) {
+	var myerr error
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	first := func(items []interface{}) interface{} {
+		return items[0]
+	}
+
+	sources := []Observable{Just(1), Just(errors.New("bang"))}
+	sub := CombineLatest(sources, first).Subscribe(onError)
+	<-sub
+
+	assert.EqualError(t, myerr, "bang")
+}
+
+func TestCombineLatestDelayError(t *testing.T) {
+	left := make(chan interface{})
+	right := make(chan interface{})
+
+	sum := func(items []interface{}) interface{} {
+		return items[0].(int) + items[1].(int)
+	}
+
+	myStream := CombineLatestDelayError([]Observable{left, right}, sum)
+
+	leftErr := errors.New("left")
+	rightErr := errors.New("right")
+	left <- 1
+	right <- 10
+	assert.Equal(t, 11, <-myStream)
+
+	left <- leftErr
+	right <- 20
+	assert.Equal(t, 21, <-myStream)
+
+	right <- rightErr
+	err := (<-myStream).(rxerrors.BaseError)
+	assert.ElementsMatch(t, []error{leftErr, rightErr}, err.Errors())
+	_, ok := <-myStream
+	assert.False(t, ok)
+
+	sources := []Observable{Just(1, 2), Just(leftErr)}
+	assert.Exactly(t, []interface{}{leftErr}, drain(CombineLatestDelayError(sources, sum)))
+}
+
+func TestConcatOperator(t *testing.T) {
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	// The delayed source must still come first.
+	slow := Start(func() interface{} {
+		<-time.After(10 * time.Millisecond)
+		return 1
+	})
+
+	sub := Concat(slow, Just(2, 3), Empty(), Range(4, 6)).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []int{1, 2, 3, 4, 5}, nums)
+}
+
+func TestConcatOperatorWithError(t *testing.T) {
+	nums := []int{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Concat(Just(1, errors.New("bang")), Just(2)).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []int{1}, nums)
+	assert.EqualError(t, myerr, "bang")
+}
+
+func TestMergeDelayError(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+
+	items := drain(MergeDelayError(Just(1, first, 2), Just(3, 4), Just(second)))
+	assert.Len(t, items, 4)
+	assert.ElementsMatch(t, []interface{}{1, 3, 4}, items[:3])
+	err := items[3].(rxerrors.BaseError)
+	assert.ElementsMatch(t, []error{first, second}, err.Errors())
+
+	items = drain(MergeDelayError(Just(first), Just(1)))
+	assert.Exactly(t, []interface{}{1, first}, items)
+}
+
+func TestConcatDelayError(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+
+	items := drain(ConcatDelayError(Just(1, first, 2), Just(3), Just(second)))
+	assert.Len(t, items, 3)
+	assert.Exactly(t, []interface{}{1, 3}, items[:2])
+	assert.Exactly(t, []error{first, second}, items[2].(rxerrors.BaseError).Errors())
+
+	assert.Exactly(t, []interface{}{1, 2}, drain(ConcatDelayError(Just(1), Just(2))))
+}
+
+func TestAmbOperator(t *testing.T) {
+	slow := make(chan interface{})
+	fast := make(chan interface{})
+	myStream := Amb(Observable(slow), Observable(fast))
+
+	go func() {
+		fast <- 1
+		fast <- 2
+		close(fast)
+	}()
+	assert.Exactly(t, []interface{}{1, 2}, drain(myStream))
+
+	myerr := errors.New("bang")
+	assert.Exactly(t, []interface{}{myerr}, drain(Amb(Never(), Just(myerr, 1))))
+	assert.Empty(t, drain(Amb(Never(), Empty())))
+	assert.Empty(t, drain(Amb()))
+
+	// The losers are stopped.
+	never := Never()
+	assert.Exactly(t, []interface{}{1}, drain(Amb(never, Just(1))))
+	assert.True(t, waitClosed(never))
+}
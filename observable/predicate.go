@@ -0,0 +1,114 @@
+package observable
+
+import (
+	"reflect"
+
+	"github.com/reactivex/rxgo/fx"
+)
+
+// All returns a new Observable which emits true if every item in the original
+// Observable satisfies a FilterableFunc predicate. It emits false and stops
+// reading the original Observable as soon as an item does not.
+// An error is passed on and terminates the new Observable.
+func (o Observable) All(apply fx.FilterableFunc) Observable {
+	return o.decide(func(item interface{}) bool {
+		return !apply(item)
+	}, false)
+}
+
+// Any returns a new Observable which emits true and stops reading the original
+// Observable as soon as an item satisfies a FilterableFunc predicate, and
+// false if none does.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Any(apply fx.FilterableFunc) Observable {
+	return o.decide(apply, true)
+}
+
+// Contains returns a new Observable which emits true and stops reading the
+// original Observable as soon as an item deeply equal to the given one is
+// found, and false if there is none.
+// An error is passed on and terminates the new Observable.
+func (o Observable) Contains(target interface{}) Observable {
+	return o.decide(func(item interface{}) bool {
+		return reflect.DeepEqual(item, target)
+	}, true)
+}
+
+// IsEmpty returns a new Observable which emits true if the original Observable
+// completes without emitting any item. It emits false and stops reading the
+// original Observable as soon as an item arrives.
+// An error is passed on and terminates the new Observable.
+func (o Observable) IsEmpty() Observable {
+	return o.decide(func(interface{}) bool {
+		return true
+	}, false)
+}
+
+// decide emits answer as soon as an item satisfies found, and its opposite if
+// the original Observable completes first. Once decided, it stops the original
+// Observable and drains it, so that its producer does not stay blocked.
+func (o Observable) decide(found fx.FilterableFunc, answer bool) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		var result interface{} = !answer
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				result = item
+				break
+			}
+			if found(item) {
+				result = answer
+				cancelUpstream(o)
+				break
+			}
+		}
+		out <- result
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// SequenceEqual returns a new Observable which emits true if the original
+// Observable and other emit the same items in the same order, compared by a
+// ComparatorFunc, or reflect.DeepEqual if it is nil. It emits false and stops
+// reading both Observables as soon as they differ.
+// An error from either Observable is passed on and terminates the new Observable.
+func (o Observable) SequenceEqual(other Observable, apply fx.ComparatorFunc) Observable {
+	if apply == nil {
+		apply = reflect.DeepEqual
+	}
+	out := make(chan interface{})
+	link(out, o, other)
+	go func() {
+		var result interface{} = true
+		for {
+			a, aok := <-o
+			if err, isErr := a.(error); aok && isErr {
+				result = err
+				break
+			}
+			b, bok := <-other
+			if err, isErr := b.(error); bok && isErr {
+				result = err
+				break
+			}
+			if !aok || !bok {
+				result = aok == bok
+				break
+			}
+			if !apply(a, b) {
+				result = false
+				break
+			}
+		}
+		if result != true {
+			// Stop and drain whichever Observable is left unread.
+			cancelUpstream(o)
+			cancelUpstream(other)
+		}
+		out <- result
+		closeOut(out)
+	}()
+	return Observable(out)
+}
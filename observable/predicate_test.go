@@ -0,0 +1,93 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	positive := func(item interface{}) bool {
+		return item.(int) > 0
+	}
+
+	assert.Equal(t, true, <-Just(1, 2, 3).All(positive))
+	assert.Equal(t, true, <-Empty().All(positive))
+
+	// The answer is known before the source completes.
+	source := make(chan interface{})
+	myStream := Observable(source).All(positive)
+	source <- 1
+	source <- -1
+	assert.Equal(t, false, <-myStream)
+}
+
+func TestAny(t *testing.T) {
+	negative := func(item interface{}) bool {
+		return item.(int) < 0
+	}
+
+	assert.Equal(t, false, <-Just(1, 2, 3).Any(negative))
+
+	source := make(chan interface{})
+	myStream := Observable(source).Any(negative)
+	source <- -1
+	assert.Equal(t, true, <-myStream)
+
+	// The source is drained once decided, and its producer stopped.
+	sent := make(chan struct{})
+	go func() {
+		source <- -2
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		assert.Fail(t, "source left blocked once decided")
+	}
+	assert.Equal(t, true, <-Repeat(-1).Any(negative))
+}
+
+func TestContains(t *testing.T) {
+	assert.Equal(t, true, <-Just(1, []int{2}, 3).Contains([]int{2}))
+	assert.Equal(t, false, <-Just(1, 2, 3).Contains(4))
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, <-Just(1, myerr, 4).Contains(4))
+}
+
+func TestIsEmpty(t *testing.T) {
+	assert.Equal(t, true, <-Empty().IsEmpty())
+
+	source := make(chan interface{})
+	myStream := Observable(source).IsEmpty()
+	source <- 1
+	assert.Equal(t, false, <-myStream)
+}
+
+func TestSequenceEqual(t *testing.T) {
+	assert.Equal(t, true, <-Just(1, 2, 3).SequenceEqual(Just(1, 2, 3), nil))
+	assert.Equal(t, false, <-Just(1, 2, 3).SequenceEqual(Just(1, 2), nil))
+	assert.Equal(t, false, <-Just(1, 2).SequenceEqual(Just(1, 2, 3), nil))
+	assert.Equal(t, false, <-Just(1, 2, 3).SequenceEqual(Just(1, 5, 3), nil))
+	assert.Equal(t, true, <-Empty().SequenceEqual(Empty(), nil))
+
+	sameParity := func(a, b interface{}) bool {
+		return a.(int)%2 == b.(int)%2
+	}
+	assert.Equal(t, true, <-Just(1, 2).SequenceEqual(Just(3, 4), sameParity))
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, <-Just(1, 2).SequenceEqual(Just(1, myerr), nil))
+
+	// Both Observables are stopped once they differ.
+	left, right := Interval(nil, time.Millisecond), Interval(nil, time.Millisecond)
+	differ := func(a, b interface{}) bool {
+		return a.(int) < 2
+	}
+	assert.Equal(t, false, <-left.SequenceEqual(right, differ))
+	assert.True(t, waitClosed(left))
+	assert.True(t, waitClosed(right))
+}
@@ -0,0 +1,107 @@
+package observable
+
+import (
+	"math"
+
+	"github.com/reactivex/rxgo/errors"
+)
+
+// RangeOption configures the range constructors.
+type RangeOption func(*rangeConfig)
+
+type rangeConfig struct {
+	inclusive bool
+}
+
+// Inclusive makes a range emit its end too, should a step land on it. By
+// default, ranges exclude their end.
+func Inclusive() RangeOption {
+	return func(c *rangeConfig) {
+		c.inclusive = true
+	}
+}
+
+func newRangeConfig(opts []RangeOption) rangeConfig {
+	var c rangeConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// RangeStep creates an Observable emitting the integers from start towards
+// end, step apart. A negative step makes a descending range. A zero step
+// emits an error.
+func RangeStep(start, end, step int, opts ...RangeOption) Observable {
+	c := newRangeConfig(opts)
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+		if step == 0 {
+			trySend(source, errors.New(errors.ObservableError, "range step is zero"), quit)
+		}
+		for i := start; step != 0 && c.withinInt(i, end, step > 0); i += step {
+			if !trySend(source, i, quit) {
+				break
+			}
+			// The next step would overflow, hence go past any end.
+			if step > 0 && i > math.MaxInt-step || step < 0 && i < math.MinInt-step {
+				break
+			}
+		}
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// RangeFloat64 creates an Observable emitting the float64 values from start
+// towards end, step apart. A negative step makes a descending range. A zero
+// step emits an error. Each value is computed from start, so that rounding
+// errors do not add up.
+func RangeFloat64(start, end, step float64, opts ...RangeOption) Observable {
+	c := newRangeConfig(opts)
+	source := make(chan interface{})
+	quit := stoppable(source)
+	go func() {
+		if step == 0 {
+			trySend(source, errors.New(errors.ObservableError, "range step is zero"), quit)
+		}
+		for i := 0; step != 0; i++ {
+			v := start + float64(i)*step
+			if !c.within(v, end, step > 0) || !trySend(source, v, quit) {
+				break
+			}
+		}
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+// within reports whether v has not gone past end yet.
+func (c rangeConfig) within(v, end float64, ascending bool) bool {
+	switch {
+	case ascending && c.inclusive:
+		return v <= end
+	case ascending:
+		return v < end
+	case c.inclusive:
+		return v >= end
+	default:
+		return v > end
+	}
+}
+
+// withinInt reports whether v has not gone past end yet, comparing ints as
+// such since float64 cannot tell large ints apart.
+func (c rangeConfig) withinInt(v, end int, ascending bool) bool {
+	switch {
+	case ascending && c.inclusive:
+		return v <= end
+	case ascending:
+		return v < end
+	case c.inclusive:
+		return v >= end
+	default:
+		return v > end
+	}
+}
@@ -0,0 +1,36 @@
+package observable
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeStep(t *testing.T) {
+	assert.Exactly(t, []interface{}{0, 3, 6}, drain(RangeStep(0, 9, 3)))
+	assert.Exactly(t, []interface{}{0, 3, 6, 9}, drain(RangeStep(0, 9, 3, Inclusive())))
+	assert.Exactly(t, []interface{}{5, 3, 1}, drain(RangeStep(5, 0, -2)))
+	assert.Exactly(t, []interface{}{3, 2, 1}, drain(RangeStep(3, 1, -1, Inclusive())))
+	assert.Empty(t, drain(RangeStep(3, 1, 1)))
+
+	// Large ints are compared exactly, and an inclusive end at the bounds
+	// of int does not overflow.
+	assert.Exactly(t, []interface{}{math.MaxInt - 3, math.MaxInt - 2}, drain(RangeStep(math.MaxInt-3, math.MaxInt-1, 1)))
+	assert.Exactly(t, []interface{}{math.MaxInt - 2, math.MaxInt}, drain(RangeStep(math.MaxInt-2, math.MaxInt, 2, Inclusive())))
+	assert.Exactly(t, []interface{}{math.MinInt + 1, math.MinInt}, drain(RangeStep(math.MinInt+1, math.MinInt, -1, Inclusive())))
+
+	items := drain(RangeStep(0, 1, 0))
+	if assert.Len(t, items, 1) {
+		assert.Error(t, items[0].(error))
+	}
+}
+
+func TestRangeFloat64(t *testing.T) {
+	assert.Exactly(t, []interface{}{0.0, 0.5, 1.0}, drain(RangeFloat64(0, 1.5, 0.5)))
+	assert.Exactly(t, []interface{}{1.0, 0.75, 0.5}, drain(RangeFloat64(1, 0.5, -0.25, Inclusive())))
+
+	items := drain(RangeFloat64(0, 1, 0.1))
+	assert.Len(t, items, 10)
+	assert.InDelta(t, 0.9, items[9], 1e-9)
+}
@@ -0,0 +1,158 @@
+package observable
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ConnectionState describes the connection of a Reconnecting source.
+type ConnectionState int
+
+const (
+	Connecting ConnectionState = iota
+	Connected
+	Disconnected
+)
+
+// String returns the name of a ConnectionState.
+func (s ConnectionState) String() string {
+	switch s {
+	case Connecting:
+		return "Connecting"
+	case Connected:
+		return "Connected"
+	case Disconnected:
+		return "Disconnected"
+	default:
+		return fmt.Sprintf("ConnectionState(%d)", int(s))
+	}
+}
+
+// Reconnecting creates an Observable which mirrors a source created by factory
+// and transparently switches to a fresh one whenever the source errors or
+// completes, waiting as long as the BackoffPolicy tells it to. The attempt
+// count is reset as soon as a source emits an item. Once the policy gives up,
+// the last error, if any, is emitted and the Observable completes. It also
+// completes once term is closed, such as the Terminated channel of a
+// Subscription, or once a Subscription to it is disposed of, stopping the
+// current source.
+//
+// ConnectionState transitions are emitted on the second Observable, which is
+// buffered so that a slow state consumer never stalls the items.
+func Reconnecting(term <-chan struct{}, factory func() Observable, policy BackoffPolicy) (Observable, Observable) {
+	out := make(chan interface{})
+	r := newRelay(out)
+	states, stateStream := sideOutput()
+	clock := CurrentClock()
+	go func() {
+		attempt := 0
+	OuterLoop:
+		for {
+			states <- Connecting
+			source := factory()
+			if !r.follow(source) {
+				states <- Disconnected
+				break
+			}
+			states <- Connected
+
+			var failure error
+		SourceLoop:
+			for {
+				select {
+				case <-term:
+					cancelUpstream(source)
+					states <- Disconnected
+					break OuterLoop
+				case item, ok := <-source:
+					if !ok {
+						break SourceLoop
+					}
+					if err, isErr := item.(error); isErr {
+						failure = err
+						cancelUpstream(source)
+						break SourceLoop
+					}
+					attempt = 0
+					select {
+					case out <- item:
+					case <-term:
+						cancelUpstream(source)
+						states <- Disconnected
+						break OuterLoop
+					}
+				}
+			}
+			states <- Disconnected
+
+			attempt++
+			delay, retry := policy.Backoff(attempt)
+			if !retry {
+				if failure != nil {
+					select {
+					case out <- failure:
+					case <-term:
+					}
+				}
+				break
+			}
+			select {
+			case <-term:
+				break OuterLoop
+			case <-r.quit:
+				break OuterLoop
+			case <-clock.After(delay):
+			}
+		}
+		close(states)
+		closeOut(out)
+	}()
+	return Observable(out), stateStream
+}
+
+// sideOutput returns a channel whose items are queued without bound and
+// emitted on the returned Observable, so that emitting on a side Observable
+// never blocks the main stream. Closing the channel completes the Observable
+// once the queue is drained. Once the Observable is stopped, the queue is
+// dropped and the items sent are discarded.
+func sideOutput() (chan<- interface{}, Observable) {
+	in := make(chan interface{})
+	out := make(chan interface{})
+	quit := stoppable(out)
+	depth := queued(out)
+	go func() {
+		queue := []interface{}{}
+		source := in
+		for source != nil || len(queue) > 0 {
+			atomic.StoreInt64(depth, int64(len(queue)))
+			var send chan<- interface{}
+			var next interface{}
+			if len(queue) > 0 {
+				send = out
+				next = queue[0]
+			}
+
+			select {
+			case item, ok := <-source:
+				if !ok {
+					source = nil
+					continue
+				}
+				queue = append(queue, item)
+			case send <- next:
+				queue = queue[1:]
+			case <-quit:
+				// Nobody reads anymore: drop the queue, and discard the
+				// items until the input is closed.
+				quit, queue = nil, nil
+				if source != nil {
+					for range source {
+					}
+					source = nil
+				}
+			}
+		}
+		closeOut(out)
+	}()
+	return in, Observable(out)
+}
@@ -0,0 +1,93 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnecting(t *testing.T) {
+	connections := 0
+	factory := func() Observable {
+		connections++
+		switch connections {
+		case 1:
+			return Just(1, errors.New("connection reset"))
+		case 2:
+			return Just(2, 3)
+		default:
+			return Just(errors.New("connection refused"))
+		}
+	}
+
+	policy := ConstantBackoff{Delay: time.Millisecond, MaxAttempts: 2}
+	myStream, stateStream := Reconnecting(nil, factory, policy)
+
+	states := []ConnectionState{}
+	statesDone := make(chan struct{})
+	go func() {
+		for state := range stateStream {
+			states = append(states, state.(ConnectionState))
+		}
+		close(statesDone)
+	}()
+
+	nums := []int{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := myStream.Subscribe(observer.New(onNext, onError))
+	<-sub
+	<-statesDone
+
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+	assert.EqualError(t, myerr, "connection refused")
+	assert.Equal(t, 4, connections)
+	assert.Len(t, states, 12)
+	assert.Equal(t, Connecting, states[0])
+	assert.Equal(t, Connected, states[1])
+	assert.Equal(t, Disconnected, states[11])
+}
+
+func TestReconnectingTerminated(t *testing.T) {
+	// An idle source is stopped.
+	term := make(chan struct{})
+	idle := make(chan interface{})
+	factory := func() Observable {
+		return Observable(idle)
+	}
+	myStream, stateStream := Reconnecting(term, factory, ConstantBackoff{})
+
+	close(term)
+	assert.Empty(t, drain(myStream))
+	assert.Exactly(t, []interface{}{Connecting, Connected, Disconnected}, drain(stateStream))
+
+	// So is a backoff.
+	term = make(chan struct{})
+	factory = func() Observable {
+		return Just(1)
+	}
+	myStream, stateStream = Reconnecting(term, factory, ConstantBackoff{Delay: time.Hour})
+
+	assert.Equal(t, 1, <-myStream)
+	close(term)
+	assert.Empty(t, drain(myStream))
+	assert.Exactly(t, []interface{}{Connecting, Connected, Disconnected}, drain(stateStream))
+}
+
+func TestConnectionStateString(t *testing.T) {
+	assert.Equal(t, "Connecting", Connecting.String())
+	assert.Equal(t, "Connected", Connected.String())
+	assert.Equal(t, "Disconnected", Disconnected.String())
+	assert.Equal(t, "ConnectionState(7)", ConnectionState(7).String())
+}
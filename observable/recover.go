@@ -0,0 +1,89 @@
+package observable
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observer"
+)
+
+var (
+	panicMu            sync.RWMutex
+	globalPanicHandler func(error)
+)
+
+// SetPanicHandler sets the func called with a panic recovered from the
+// ErrHandler or DoneHandler of a subscribed Observer, as a HandlerError. A
+// panic of the NextHandler is passed to the ErrHandler instead. A nil func,
+// the default, leaves the panic recorded in the Subscription only.
+func SetPanicHandler(fn func(error)) {
+	panicMu.Lock()
+	globalPanicHandler = fn
+	panicMu.Unlock()
+}
+
+func currentPanicHandler() func(error) {
+	panicMu.RLock()
+	defer panicMu.RUnlock()
+	return globalPanicHandler
+}
+
+// SetUnhandledErrorHandler sets the func called with an error emitted to a
+// subscriber which provided no ErrHandler, instead of dropping it silently.
+// A nil func, the default, drops such errors.
+func SetUnhandledErrorHandler(fn func(error)) {
+	observer.SetUnhandledErrorHandler(fn)
+}
+
+// recovered calls fn and returns the value it panicked with, if any, as a
+// HandlerError.
+func recovered(fn func()) (err error) {
+	defer func() {
+		r := recover()
+		if cause, isErr := r.(error); isErr {
+			err = errors.Wrap(errors.HandlerError, cause, "handler panicked")
+		} else if r != nil {
+			err = errors.New(errors.HandlerError, fmt.Sprintf("handler panicked: %v", r))
+		}
+	}()
+	fn()
+	return nil
+}
+
+// onNext passes an item to the Observer. A panic of its NextHandler is passed
+// to its ErrHandler and returned, terminating the subscription.
+func onNext(ob observer.Observer, item interface{}) error {
+	err := recovered(func() {
+		ob.OnNext(item)
+	})
+	if err != nil {
+		onError(ob, err)
+	}
+	return err
+}
+
+// onError passes an error to the Observer. A panic of its ErrHandler is
+// passed to the panic handler.
+func onError(ob observer.Observer, err error) {
+	handlePanic(recovered(func() {
+		ob.OnError(err)
+	}))
+}
+
+// onDone notifies the Observer of the completion. A panic of its DoneHandler
+// is passed to the panic handler and returned.
+func onDone(ob observer.Observer) error {
+	err := recovered(ob.OnDone)
+	handlePanic(err)
+	return err
+}
+
+func handlePanic(err error) {
+	if err == nil {
+		return
+	}
+	if fn := currentPanicHandler(); fn != nil {
+		fn(err)
+	}
+}
@@ -0,0 +1,88 @@
+package observable
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribeRecoversNextHandler(t *testing.T) {
+	myerr := errors.New("bang")
+	var handled error
+	ob := observer.New(
+		handlers.NextFunc(func(item interface{}) {
+			if item == 2 {
+				panic(myerr)
+			}
+		}),
+		handlers.ErrFunc(func(err error) {
+			handled = err
+		}),
+	)
+
+	sub := <-Just(1, 2, 3).Subscribe(ob)
+
+	assert.Equal(t, handled, sub.Err())
+	assert.True(t, errors.Is(handled, myerr))
+	assert.True(t, errors.Is(handled, rxerrors.New(rxerrors.HandlerError)))
+}
+
+func TestSubscribeRecoversDoneHandler(t *testing.T) {
+	var panicked error
+	SetPanicHandler(func(err error) {
+		panicked = err
+	})
+	defer SetPanicHandler(nil)
+
+	onDone := handlers.DoneFunc(func() {
+		panic("oops")
+	})
+	sub := <-Just(1).Subscribe(onDone)
+
+	assert.EqualError(t, panicked, "2 - handler panicked: oops")
+	assert.Equal(t, panicked, sub.Err())
+
+	panicked = nil
+	sub = <-Just(1).SubscribeWithContext(context.Background(), onDone)
+	assert.EqualError(t, panicked, "2 - handler panicked: oops")
+	assert.Equal(t, panicked, sub.Err())
+}
+
+func TestSubscribeRecoversErrHandler(t *testing.T) {
+	var panicked error
+	SetPanicHandler(func(err error) {
+		panicked = err
+	})
+	defer SetPanicHandler(nil)
+
+	myerr := errors.New("bang")
+	onError := handlers.ErrFunc(func(err error) {
+		panic(err)
+	})
+	sub := <-Throw(myerr).Subscribe(onError)
+
+	assert.Equal(t, myerr, sub.Err())
+	assert.True(t, errors.Is(panicked, myerr))
+}
+
+func TestUnhandledErrorHandler(t *testing.T) {
+	var unhandled []error
+	SetUnhandledErrorHandler(func(err error) {
+		unhandled = append(unhandled, err)
+	})
+	defer SetUnhandledErrorHandler(nil)
+
+	myerr := errors.New("bang")
+	onNext := handlers.NextFunc(func(item interface{}) {})
+	<-Just(1, myerr).Subscribe(onNext)
+	assert.Exactly(t, []error{myerr}, unhandled)
+
+	onError := handlers.ErrFunc(func(err error) {})
+	<-Just(1, myerr).Subscribe(onError)
+	assert.Len(t, unhandled, 1)
+}
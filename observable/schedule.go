@@ -0,0 +1,148 @@
+package observable
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/reactivex/rxgo/fx"
+	"github.com/reactivex/rxgo/scheduler"
+)
+
+// SubscribeOn starts reading the original Observable from a task run by the
+// given Scheduler, and returns a new Observable mirroring it. The task only
+// hands the reading over to a goroutine of its own, so that a Scheduler
+// running it synchronously, such as scheduler.Immediate, never blocks before
+// the new Observable is returned.
+func (o Observable) SubscribeOn(s scheduler.Scheduler) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	s.Schedule(func() {
+		go func() {
+			for item := range o {
+				out <- item
+			}
+			closeOut(out)
+		}()
+	})
+	return Observable(out)
+}
+
+// ObserveOn returns a new Observable whose items are emitted from tasks run by
+// the given Scheduler, in the order of the original Observable. Items are
+// queued without bound while waiting for the Scheduler.
+func (o Observable) ObserveOn(s scheduler.Scheduler) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	depth := queued(out)
+	var mu sync.Mutex
+	queue := []interface{}{}
+	draining := false
+	completed := false
+
+	// drain emits the queued items; at most one drain is ever scheduled at a
+	// time, which keeps the items in order.
+	drain := func() {
+		for {
+			mu.Lock()
+			if len(queue) == 0 {
+				draining = false
+				if completed {
+					closeOut(out)
+				}
+				mu.Unlock()
+				return
+			}
+			item := queue[0]
+			queue = queue[1:]
+			atomic.StoreInt64(depth, int64(len(queue)))
+			mu.Unlock()
+
+			out <- item
+		}
+	}
+
+	// push queues an item, if any, and schedules a drain unless one is
+	// already pending.
+	push := func(item interface{}, done bool) {
+		mu.Lock()
+		if done {
+			completed = true
+		} else {
+			queue = append(queue, item)
+			atomic.StoreInt64(depth, int64(len(queue)))
+		}
+		start := !draining
+		draining = true
+		mu.Unlock()
+
+		if start {
+			s.Schedule(drain)
+		}
+	}
+
+	go func() {
+		for item := range o {
+			push(item, false)
+			if _, isErr := item.(error); isErr {
+				break
+			}
+		}
+		push(nil, true)
+	}()
+	return Observable(out)
+}
+
+// StartOn is like Start but runs each directive as a task of the given
+// Scheduler rather than on a goroutine of its own, so that a
+// scheduler.WorkerPool caps how many of them run at once.
+func StartOn(s scheduler.Scheduler, f fx.EmittableFunc, fs ...fx.EmittableFunc) Observable {
+	fs = append([]fx.EmittableFunc{f}, fs...)
+	source := make(chan interface{})
+	quit := stoppable(source)
+
+	var wg sync.WaitGroup
+	wg.Add(len(fs))
+	for _, f := range fs {
+		f := f
+		s.Schedule(func() {
+			item := f()
+			// Hand the result over so that the worker is free for the next
+			// directive even if nobody reads it yet.
+			go func() {
+				trySend(source, item, quit)
+				wg.Done()
+			}()
+		})
+	}
+
+	go func() {
+		wg.Wait()
+		closeOut(source)
+	}()
+	return Observable(source)
+}
+
+var (
+	clockMu     sync.RWMutex
+	globalClock scheduler.Clock = scheduler.RealClock
+)
+
+// SetClock sets the Clock of the time-based operators created afterwards, such
+// as Interval, Delay or Sample. A nil Clock restores the wall clock. Tests set
+// a scheduler.TestScheduler to control the time.
+func SetClock(c scheduler.Clock) {
+	if c == nil {
+		c = scheduler.RealClock
+	}
+	clockMu.Lock()
+	globalClock = c
+	clockMu.Unlock()
+}
+
+// CurrentClock returns the Clock set by SetClock, for the time-based sources
+// of other packages.
+func CurrentClock() scheduler.Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return globalClock
+}
@@ -0,0 +1,157 @@
+package observable
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/fx"
+	"github.com/reactivex/rxgo/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribeOn(t *testing.T) {
+	loop := scheduler.NewEventLoop()
+	defer loop.Stop()
+
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(Just(1, 2, 3).SubscribeOn(loop)))
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(Just(1, 2, 3).SubscribeOn(scheduler.Immediate)))
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(Just(1, 2, 3).SubscribeOn(scheduler.NewTrampoline())))
+}
+
+func TestObserveOn(t *testing.T) {
+	pool := scheduler.NewWorkerPool(4)
+	defer pool.Stop()
+
+	source := Range(0, 100).ObserveOn(pool)
+	items := drain(source)
+	assert.Len(t, items, 100)
+	for i, item := range items {
+		assert.Equal(t, i, item)
+	}
+
+	myerr := errors.New("bang")
+	assert.Exactly(t, []interface{}{1, myerr}, drain(Just(1, myerr, 2).ObserveOn(scheduler.Immediate)))
+}
+
+func TestIntervalWithTestScheduler(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	term := make(chan struct{})
+	myStream := Interval(term, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		s.BlockUntil(1)
+		s.AdvanceBy(time.Minute)
+		assert.Equal(t, i, <-myStream)
+	}
+	assert.Equal(t, time.Unix(180, 0), s.Now())
+	close(term)
+}
+
+func TestIntervalWithOptions(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	myStream := Interval(nil, time.Minute, WithInitialDelay(time.Second), WithCount(2))
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Second)
+	assert.Equal(t, 0, <-myStream)
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Minute)
+	assert.Equal(t, 1, <-myStream)
+
+	_, ok := <-myStream
+	assert.False(t, ok)
+	assert.Equal(t, time.Unix(61, 0), s.Now())
+}
+
+func TestTimerWithTestScheduler(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	myStream := Timer(nil, time.Second)
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Second)
+	assert.Exactly(t, []interface{}{0}, drain(myStream))
+
+	term := make(chan struct{})
+	myStream = Timer(term, time.Second, time.Minute)
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Second)
+	assert.Equal(t, 0, <-myStream)
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Minute)
+	assert.Equal(t, 1, <-myStream)
+	assert.Equal(t, time.Unix(62, 0), s.Now())
+
+	close(term)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestSampleWithTestScheduler(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	SetClock(s)
+	defer SetClock(nil)
+
+	source := make(chan interface{})
+	myStream := Observable(source).Sample(time.Second)
+
+	source <- 1
+	source <- 2
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Second)
+	assert.Equal(t, 2, <-myStream)
+
+	source <- 3
+	close(source)
+	assert.Equal(t, 3, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestStartOn(t *testing.T) {
+	pool := scheduler.NewWorkerPool(2)
+	defer pool.Stop()
+
+	var mu sync.Mutex
+	active, peak := 0, 0
+	directive := func() interface{} {
+		mu.Lock()
+		active++
+		if active > peak {
+			peak = active
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return 1
+	}
+
+	fs := []fx.EmittableFunc{}
+	for i := 0; i < 9; i++ {
+		fs = append(fs, directive)
+	}
+
+	sum := 0
+	for item := range StartOn(pool, directive, fs...) {
+		sum += item.(int)
+	}
+	assert.Equal(t, 10, sum)
+	assert.True(t, peak <= 2, peak)
+
+	assert.Exactly(t, []interface{}{1}, drain(StartOn(scheduler.Immediate, func() interface{} {
+		return 1
+	})))
+}
@@ -0,0 +1,135 @@
+package observable
+
+import (
+	"container/heap"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/fx"
+)
+
+// Gap reports a range of missing sequence numbers, From and To included.
+type Gap struct {
+	From uint64
+	To   uint64
+}
+
+// DetectGaps mirrors the original Observable while watching the monotonically
+// increasing sequence number of its items, and emits a Gap on the second
+// Observable for each range of sequence numbers which have been skipped.
+// Items whose sequence number does not increase are not reported.
+func (o Observable) DetectGaps(seq fx.SequenceFunc) (Observable, Observable) {
+	out := make(chan interface{})
+	link(out, o)
+	gaps, gapStream := sideOutput()
+	go func() {
+		var next uint64
+		started := false
+		for item := range o {
+			if _, isErr := item.(error); !isErr {
+				n := seq(item)
+				if started && n > next {
+					gaps <- Gap{From: next, To: n - 1}
+				}
+				if !started || n >= next {
+					next = n + 1
+					started = true
+				}
+			}
+			out <- item
+		}
+		close(gaps)
+		closeOut(out)
+	}()
+	return Observable(out), gapStream
+}
+
+// Reorder buffers out-of-order items of the original Observable and emits them
+// in the order of their sequence number. Up to window items are held back
+// while waiting for a missing sequence number; once the window is full the
+// lowest buffered item is emitted and the gap is skipped. An item arriving
+// after its turn has been skipped is outside the window and emits an error.
+// Buffered items are held against the Budget.
+func (o Observable) Reorder(seq fx.SequenceFunc, window int) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		budget := CurrentBudget()
+		pending := &sequenceHeap{}
+		var next uint64
+		started := false
+
+		// release emits the buffered items which are due, which are all of
+		// them when flushing.
+		release := func(flush bool) {
+			for pending.Len() > 0 {
+				min := (*pending)[0]
+				if started && min.seq < next {
+					// Duplicate of an item already emitted.
+					heap.Pop(pending)
+					budget.Release(1)
+					continue
+				}
+				due := started && min.seq == next
+				if !due && !flush && pending.Len() <= window {
+					return
+				}
+				heap.Pop(pending)
+				budget.Release(1)
+				out <- min.item
+				next = min.seq + 1
+				started = true
+			}
+		}
+
+	OuterLoop:
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				release(true)
+				out <- item
+				break OuterLoop
+			}
+
+			n := seq(item)
+			if started && n < next {
+				out <- errors.New(errors.ObservableError, "item is outside of the reordering window")
+				budget.Release(pending.Len())
+				*pending = nil
+				break OuterLoop
+			}
+
+			if !budget.Acquire() {
+				if err := budget.Shed(); err != nil {
+					release(true)
+					out <- err
+					break OuterLoop
+				}
+				continue
+			}
+			heap.Push(pending, sequenced{seq: n, item: item})
+			release(false)
+		}
+		release(true)
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+type sequenced struct {
+	seq  uint64
+	item interface{}
+}
+
+// sequenceHeap implements heap.Interface as a min-heap of sequence numbers.
+type sequenceHeap []sequenced
+
+func (h sequenceHeap) Len() int            { return len(h) }
+func (h sequenceHeap) Less(i, j int) bool  { return h[i].seq < h[j].seq }
+func (h sequenceHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *sequenceHeap) Push(x interface{}) { *h = append(*h, x.(sequenced)) }
+
+func (h *sequenceHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,76 @@
+package observable
+
+import (
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservableDetectGaps(t *testing.T) {
+	seq := func(item interface{}) uint64 {
+		return uint64(item.(int))
+	}
+
+	myStream, gapStream := Just(3, 4, 7, 8, 8, 5, 12).DetectGaps(seq)
+
+	gaps := []Gap{}
+	gapsDone := make(chan struct{})
+	go func() {
+		for gap := range gapStream {
+			gaps = append(gaps, gap.(Gap))
+		}
+		close(gapsDone)
+	}()
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	sub := myStream.Subscribe(onNext)
+	<-sub
+	<-gapsDone
+
+	assert.Exactly(t, []int{3, 4, 7, 8, 8, 5, 12}, nums)
+	assert.Exactly(t, []Gap{{5, 6}, {9, 11}}, gaps)
+}
+
+func TestObservableReorder(t *testing.T) {
+	seq := func(item interface{}) uint64 {
+		return uint64(item.(int))
+	}
+
+	nums := []int{}
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+
+	sub := Just(2, 1, 3, 5, 4, 9, 7, 8, 6).Reorder(seq, 3).Subscribe(onNext)
+	<-sub
+
+	assert.Exactly(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, nums)
+}
+
+func TestObservableReorderSkipsGapsBeyondWindow(t *testing.T) {
+	seq := func(item interface{}) uint64 {
+		return uint64(item.(int))
+	}
+
+	nums := []int{}
+	var myerr error
+	onNext := handlers.NextFunc(func(item interface{}) {
+		nums = append(nums, item.(int))
+	})
+	onError := handlers.ErrFunc(func(err error) {
+		myerr = err
+	})
+
+	sub := Just(1, 3, 4, 5, 2).Reorder(seq, 2).Subscribe(observer.New(onNext, onError))
+	<-sub
+
+	assert.Exactly(t, []int{1, 3, 4, 5}, nums)
+	assert.Error(t, myerr)
+}
@@ -0,0 +1,26 @@
+package observable
+
+import (
+	"os"
+	"os/signal"
+)
+
+// FromSignals creates an Observable emitting each os.Signal received among the
+// given ones, or among all of them if none is given, so that a graceful
+// shutdown can be expressed as a TakeUntil on the main pipeline. Disposing of
+// a Subscription to the Observable stops relaying the signals, which get
+// their default behavior back, and completes the Observable.
+func FromSignals(sig ...os.Signal) Observable {
+	ch := make(chan os.Signal, 1)
+	return FromEventSource(func(emit func(interface{})) {
+		signal.Notify(ch, sig...)
+		go func() {
+			for s := range ch {
+				emit(s)
+			}
+		}()
+	}, func() {
+		signal.Stop(ch)
+		close(ch)
+	})
+}
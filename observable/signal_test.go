@@ -0,0 +1,33 @@
+package observable
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSignals(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("cannot send signals to itself on windows")
+	}
+
+	signals := make(chan interface{}, 1)
+	myStream := FromSignals(os.Interrupt)
+	sub, subs := myStream.SubscribeDisposable(handlers.NextFunc(func(item interface{}) {
+		signals <- item
+	}))
+	p, err := os.FindProcess(os.Getpid())
+	if assert.NoError(t, err) {
+		assert.NoError(t, p.Signal(os.Interrupt))
+		assert.Equal(t, os.Interrupt, <-signals)
+	}
+
+	// Disposing of the Subscription completes the Observable.
+	sub.Dispose()
+	<-subs
+	assert.True(t, waitClosed(myStream))
+}
@@ -0,0 +1,178 @@
+package observable
+
+import (
+	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/fx"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// Single is a stream emitting exactly one item or an error, such as the
+// response to an HTTP GET.
+type Single <-chan interface{}
+
+// Maybe is a stream emitting at most one item, or an error.
+type Maybe <-chan interface{}
+
+// Completable is a stream emitting no item, only completing or emitting an
+// error.
+type Completable <-chan interface{}
+
+// NewSingle creates a Single emitting the result of an EmittableFunc run
+// asynchronously, an error result being emitted as the error.
+func NewSingle(f fx.EmittableFunc) Single {
+	out := make(chan interface{}, 1)
+	go func() {
+		out <- f()
+		close(out)
+	}()
+	return Single(out)
+}
+
+// NewCompletable creates a Completable running a func asynchronously, which
+// emits the error it returns, if any.
+func NewCompletable(f func() error) Completable {
+	out := make(chan interface{}, 1)
+	go func() {
+		if err := f(); err != nil {
+			out <- err
+		}
+		close(out)
+	}()
+	return Completable(out)
+}
+
+// ToSingle returns a Single emitting the first item in the original
+// Observable, or an error if it completes without emitting any item.
+func (o Observable) ToSingle() Single {
+	return Single(o.First())
+}
+
+// Single returns a Single emitting the only item in the original Observable,
+// or an error if it completes without emitting any item or emits more than
+// one. An error is passed on.
+func (o Observable) Single() Single {
+	out := make(chan interface{}, 1)
+	link(out, o)
+	go func() {
+		var single interface{}
+		found := false
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				single, found = item, true
+				break
+			}
+			if found {
+				single = errors.New(errors.ObservableError, "observable emits more than one item")
+				cancelUpstream(o)
+				break
+			}
+			single, found = item, true
+		}
+		if !found {
+			single = errors.New(errors.NoSuchElementError, "observable is empty")
+		}
+		out <- single
+		closeOut(out)
+	}()
+	return Single(out)
+}
+
+// ToMaybe returns a Maybe emitting the first item in the original Observable,
+// if any, and stops the original Observable.
+func (o Observable) ToMaybe() Maybe {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		for item := range o {
+			cancelUpstream(o)
+			out <- item
+			break
+		}
+		closeOut(out)
+	}()
+	return Maybe(out)
+}
+
+// IgnoreElements returns a Completable which completes along with the original
+// Observable, ignoring its items, or emits its error.
+func (o Observable) IgnoreElements() Completable {
+	out := make(chan interface{})
+	link(out, o)
+	go func() {
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				cancelUpstream(o)
+				out <- item
+				break
+			}
+		}
+		closeOut(out)
+	}()
+	return Completable(out)
+}
+
+// Observable returns the Single as an Observable.
+func (s Single) Observable() Observable {
+	return Observable(s)
+}
+
+// Subscribe subscribes an EventHandler and returns a Subscription channel.
+func (s Single) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	return s.Observable().Subscribe(handler)
+}
+
+// Get blocks until the Single emits and returns its item or error.
+func (s Single) Get() (interface{}, error) {
+	item, ok := <-s
+	if !ok {
+		return nil, errors.New(errors.NoSuchElementError, "single is empty")
+	}
+	if err, isErr := item.(error); isErr {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Observable returns the Maybe as an Observable.
+func (m Maybe) Observable() Observable {
+	return Observable(m)
+}
+
+// Subscribe subscribes an EventHandler and returns a Subscription channel.
+func (m Maybe) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	return m.Observable().Subscribe(handler)
+}
+
+// Get blocks until the Maybe terminates and returns its item, if any, and
+// whether there was one, or its error.
+func (m Maybe) Get() (interface{}, bool, error) {
+	item, ok := <-m
+	if !ok {
+		return nil, false, nil
+	}
+	if err, isErr := item.(error); isErr {
+		return nil, false, err
+	}
+	return item, true, nil
+}
+
+// Observable returns the Completable as an Observable.
+func (c Completable) Observable() Observable {
+	return Observable(c)
+}
+
+// Subscribe subscribes an EventHandler and returns a Subscription channel.
+func (c Completable) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	return c.Observable().Subscribe(handler)
+}
+
+// Wait blocks until the Completable terminates and returns its error, if any.
+func (c Completable) Wait() error {
+	for item := range c {
+		if err, isErr := item.(error); isErr {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingle(t *testing.T) {
+	item, err := NewSingle(func() interface{} {
+		return 1
+	}).Get()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+
+	myerr := errors.New("bang")
+	_, err = NewSingle(func() interface{} {
+		return myerr
+	}).Get()
+	assert.Equal(t, myerr, err)
+
+	item, err = Just(1, 2).ToSingle().Get()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+
+	_, err = Empty().ToSingle().Get()
+	assert.Error(t, err)
+}
+
+func TestObservableSingle(t *testing.T) {
+	item, err := Just(1).Single().Get()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, item)
+
+	_, err = Just(1, 2).Single().Get()
+	assert.True(t, errors.Is(err, rxerrors.New(rxerrors.ObservableError)))
+
+	_, err = Empty().Single().Get()
+	assert.True(t, errors.Is(err, rxerrors.New(rxerrors.NoSuchElementError)))
+
+	myerr := errors.New("bang")
+	_, err = Just(1, myerr).Single().Get()
+	assert.Equal(t, myerr, err)
+
+	// The original Observable is stopped at its second item.
+	ticking := Interval(nil, time.Millisecond)
+	_, err = ticking.Single().Get()
+	assert.True(t, errors.Is(err, rxerrors.New(rxerrors.ObservableError)))
+	assert.True(t, waitClosed(ticking))
+}
+
+func TestSingleSubscribe(t *testing.T) {
+	var item interface{}
+	onNext := handlers.NextFunc(func(i interface{}) {
+		item = i
+	})
+	<-Just("a").ToSingle().Subscribe(onNext)
+	assert.Equal(t, "a", item)
+
+	assert.Exactly(t, []interface{}{"a"}, drain(Just("a").ToSingle().Observable()))
+}
+
+func TestMaybe(t *testing.T) {
+	item, ok, err := Just(1, 2).ToMaybe().Get()
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, item)
+
+	_, ok, err = Empty().ToMaybe().Get()
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	myerr := errors.New("bang")
+	_, ok, err = Throw(myerr).ToMaybe().Get()
+	assert.Equal(t, myerr, err)
+	assert.False(t, ok)
+
+	assert.Empty(t, drain(Empty().ToMaybe().Observable()))
+
+	ticking := Interval(nil, time.Millisecond)
+	_, ok, _ = ticking.ToMaybe().Get()
+	assert.True(t, ok)
+	assert.True(t, waitClosed(ticking))
+}
+
+func TestCompletable(t *testing.T) {
+	assert.Nil(t, NewCompletable(func() error {
+		return nil
+	}).Wait())
+
+	myerr := errors.New("bang")
+	c := NewCompletable(func() error {
+		return myerr
+	})
+
+	var err error
+	onError := handlers.ErrFunc(func(e error) {
+		err = e
+	})
+	<-c.Subscribe(onError)
+	assert.Equal(t, myerr, err)
+}
+
+func TestIgnoreElements(t *testing.T) {
+	assert.Nil(t, Just(1, 2, 3).IgnoreElements().Wait())
+
+	myerr := errors.New("bang")
+	assert.Equal(t, myerr, Just(1, myerr, 2).IgnoreElements().Wait())
+
+	assertStopped(t, "IgnoreElements", func() Observable {
+		return Interval(nil, time.Millisecond).IgnoreElements().Observable()
+	})
+}
@@ -0,0 +1,120 @@
+package observable
+
+// Operator transforms an Observable into another, usually by chaining
+// operators on it.
+type Operator func(Observable) Observable
+
+// SwapPolicy tells how a Swapper replaces a running segment.
+type SwapPolicy int
+
+const (
+	// Drain lets the replaced segment emit every item it has already
+	// received before the new segment's items are emitted.
+	Drain SwapPolicy = iota
+
+	// CutOver discards the items the replaced segment has not emitted yet.
+	CutOver
+)
+
+// Swapper replaces the segment of a Swappable Observable at runtime.
+type Swapper struct {
+	swaps    chan swapRequest
+	finished chan struct{}
+}
+
+type swapRequest struct {
+	op      Operator
+	policy  SwapPolicy
+	applied chan struct{}
+}
+
+// Swap atomically replaces the segment with a new one created by an Operator,
+// according to a SwapPolicy, and returns once the new segment is in place.
+// It does nothing once the source has completed.
+func (s *Swapper) Swap(op Operator, policy SwapPolicy) {
+	req := swapRequest{op: op, policy: policy, applied: make(chan struct{})}
+	select {
+	case s.swaps <- req:
+		<-req.applied
+	case <-s.finished:
+	}
+}
+
+// Swappable feeds the original Observable through a segment created by an
+// Operator, which can be replaced at runtime using the returned Swapper, for
+// instance to reconfigure a transformation without resubscribing.
+// The new Observable completes as soon as the segment terminates, for instance
+// with Take or on an error, and the original Observable is no longer read.
+func (o Observable) Swappable(op Operator) (Observable, *Swapper) {
+	out := make(chan interface{})
+	link(out, o)
+	s := &Swapper{
+		swaps:    make(chan swapRequest),
+		finished: make(chan struct{}),
+	}
+
+	type segment struct {
+		in   chan interface{}
+		cut  chan struct{}
+		done chan struct{}
+	}
+
+	start := func(op Operator) segment {
+		seg := segment{
+			in:   make(chan interface{}),
+			cut:  make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		go func() {
+			for item := range op(Observable(seg.in)) {
+				select {
+				case <-seg.cut:
+					// Keep draining so that the segment can terminate.
+					continue
+				default:
+				}
+				select {
+				case out <- item:
+				case <-seg.cut:
+				}
+			}
+			close(seg.done)
+		}()
+		return seg
+	}
+
+	go func() {
+		current := start(op)
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				select {
+				case current.in <- item:
+				case <-current.done:
+					// The segment has terminated, and so does the output.
+					break OuterLoop
+				}
+			case <-current.done:
+				break OuterLoop
+			case req := <-s.swaps:
+				close(current.in)
+				if req.policy == CutOver {
+					close(current.cut)
+				} else {
+					<-current.done
+				}
+				current = start(req.op)
+				close(req.applied)
+			}
+		}
+		close(s.finished)
+		close(current.in)
+		<-current.done
+		closeOut(out)
+	}()
+	return Observable(out), s
+}
@@ -0,0 +1,89 @@
+package observable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func multiplyBy(n int) Operator {
+	return func(o Observable) Observable {
+		return o.Map(func(item interface{}) interface{} {
+			return item.(int) * n
+		})
+	}
+}
+
+func TestObservableSwappable(t *testing.T) {
+	source := make(chan interface{})
+	myStream, swapper := Observable(source).Swappable(multiplyBy(10))
+
+	source <- 1
+	assert.Equal(t, 10, <-myStream)
+
+	swapper.Swap(multiplyBy(100), CutOver)
+	source <- 2
+	assert.Equal(t, 200, <-myStream)
+
+	go swapper.Swap(multiplyBy(1000), Drain)
+	go func() {
+		source <- 3
+		close(source)
+	}()
+
+	nums := []int{}
+	for item := range myStream {
+		nums = append(nums, item.(int))
+	}
+	assert.Len(t, nums, 1)
+	assert.Contains(t, []int{300, 3000}, nums[0])
+
+	// Swapping a completed Observable does nothing.
+	swapper.Swap(multiplyBy(1), Drain)
+}
+
+func TestObservableSwappableDrain(t *testing.T) {
+	source := make(chan interface{})
+	last := func(o Observable) Observable {
+		return o.Last()
+	}
+	myStream, swapper := Observable(source).Swappable(last)
+
+	source <- 1
+	source <- 2
+
+	done := make(chan struct{})
+	go func() {
+		swapper.Swap(multiplyBy(10), Drain)
+		close(done)
+	}()
+
+	// The replaced segment flushes its last item before the swap completes.
+	assert.Equal(t, 2, <-myStream)
+	<-done
+
+	source <- 3
+	assert.Equal(t, 30, <-myStream)
+	close(source)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestObservableSwappableTerminated(t *testing.T) {
+	take1 := func(o Observable) Observable {
+		return o.Take(1)
+	}
+	myStream, swapper := Just(1, 2, 3, 4).Swappable(take1)
+	assert.Equal(t, []interface{}{1}, drain(myStream))
+
+	// Swapping a terminated Observable does nothing.
+	swapper.Swap(multiplyBy(1), Drain)
+
+	source := make(chan interface{})
+	myStream, _ = Observable(source).Swappable(take1)
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+	source <- 2
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
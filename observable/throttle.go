@@ -0,0 +1,119 @@
+package observable
+
+import (
+	"time"
+)
+
+// ThrottleFirst emits the first item in the original Observable and then
+// suppresses every following item until the given duration has elapsed.
+// An error is passed on and terminates the new Observable.
+func (o Observable) ThrottleFirst(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		var last time.Time
+		for item := range o {
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break
+			}
+			if now := clock.Now(); last.IsZero() || now.Sub(last) >= d {
+				last = now
+				out <- item
+			}
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// ThrottleLast opens a window of the given duration with the first item in the
+// original Observable and emits the most recent item received once the window
+// closes. The next item opens a new window. A pending item is emitted before
+// the original Observable completes or an error is passed on.
+func (o Observable) ThrottleLast(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		var window <-chan time.Time
+		var latest interface{}
+		pending := false
+
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					if pending {
+						out <- latest
+						pending = false
+					}
+					out <- item
+					break OuterLoop
+				}
+				if window == nil {
+					window = clock.After(d)
+				}
+				latest, pending = item, true
+			case <-window:
+				out <- latest
+				window, pending = nil, false
+			}
+		}
+		if pending {
+			out <- latest
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// Sample emits the most recent item in the original Observable at every tick
+// of the given period, provided a new item arrived since the previous tick.
+// A pending item is emitted before the original Observable completes or an
+// error is passed on.
+func (o Observable) Sample(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		tick := clock.After(d)
+		var latest interface{}
+		pending := false
+
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if _, isErr := item.(error); isErr {
+					if pending {
+						out <- latest
+						pending = false
+					}
+					out <- item
+					break OuterLoop
+				}
+				latest, pending = item, true
+			case <-tick:
+				tick = clock.After(d)
+				if pending {
+					out <- latest
+					pending = false
+				}
+			}
+		}
+		if pending {
+			out <- latest
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
@@ -0,0 +1,72 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottleFirst(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).ThrottleFirst(20 * time.Millisecond)
+
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+	source <- 2
+
+	time.Sleep(30 * time.Millisecond)
+	source <- 3
+	assert.Equal(t, 3, <-myStream)
+
+	close(source)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestThrottleLast(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).ThrottleLast(50 * time.Millisecond)
+
+	source <- 1
+	source <- 2
+	source <- 3
+	assert.Equal(t, 3, <-myStream)
+
+	source <- 4
+	close(source)
+	assert.Equal(t, 4, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestThrottleLastWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	items := []interface{}{}
+	for item := range Just(1, 2, myerr, 3).ThrottleLast(time.Hour) {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{2, myerr}, items)
+}
+
+func TestSample(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).Sample(30 * time.Millisecond)
+
+	source <- 1
+	source <- 2
+	assert.Equal(t, 2, <-myStream)
+
+	select {
+	case item := <-myStream:
+		assert.Fail(t, "sample emitted without a new item", item)
+	case <-time.After(80 * time.Millisecond):
+	}
+
+	source <- 3
+	close(source)
+	assert.Equal(t, 3, <-myStream)
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
@@ -0,0 +1,202 @@
+package observable
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// upstream records, for the channel of each Observable created by the
+// operators and producers of this package, how to stop the goroutines feeding
+// it. Disposing of a Subscription thus reaches the producers at the top of a
+// chain of operators, which select on their quit channel in every emit loop.
+// It also records the length of the queue of the buffering operators feeding
+// a channel, reported by Inspector.Stats. An entry only lives until its
+// channel is closed.
+var upstream = struct {
+	sync.Mutex
+	stops  map[Observable]func()
+	depths map[Observable]*int64
+}{stops: make(map[Observable]func()), depths: make(map[Observable]*int64)}
+
+// onStop records a func stopping the goroutines feeding out, to be called
+// after the ones recorded before.
+func onStop(out Observable, fn func()) {
+	upstream.Lock()
+	if previous := upstream.stops[out]; previous != nil {
+		next := fn
+		fn = func() {
+			previous()
+			next()
+		}
+	}
+	upstream.stops[out] = fn
+	upstream.Unlock()
+}
+
+// link records that out is fed from the given sources, so that stopping out
+// stops them in turn.
+func link(out chan interface{}, sources ...Observable) {
+	onStop(out, func() {
+		for _, source := range sources {
+			stopUpstream(source)
+		}
+	})
+}
+
+// stoppable records that out is fed by a producer, and returns the quit
+// channel closed once out is stopped.
+func stoppable(out chan interface{}) <-chan struct{} {
+	quit := make(chan struct{})
+	var once sync.Once
+	onStop(out, func() {
+		once.Do(func() {
+			close(quit)
+		})
+	})
+	return quit
+}
+
+// queued records that out is fed by an operator holding items in a queue,
+// and returns the counter which the operator updates with its length.
+func queued(out chan interface{}) *int64 {
+	depth := new(int64)
+	upstream.Lock()
+	upstream.depths[out] = depth
+	upstream.Unlock()
+	return depth
+}
+
+// queueDepth returns the number of items held in the queue of the operator
+// feeding o, or 0 if o is not fed by a buffering operator.
+func queueDepth(o Observable) int {
+	upstream.Lock()
+	depth := upstream.depths[o]
+	upstream.Unlock()
+	if depth == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(depth))
+}
+
+// closeOut forgets how to stop out, and closes it.
+func closeOut(out chan interface{}) {
+	upstream.Lock()
+	delete(upstream.stops, Observable(out))
+	delete(upstream.depths, Observable(out))
+	upstream.Unlock()
+	close(out)
+}
+
+// stopUpstream stops the producers feeding an Observable, which then complete
+// along with every operator in between. It does nothing for an Observable
+// which has completed, or which is not fed by this package.
+func stopUpstream(o Observable) {
+	upstream.Lock()
+	fn := upstream.stops[o]
+	upstream.Unlock()
+	if fn != nil {
+		fn()
+	}
+}
+
+// cancelUpstream stops an Observable which is no longer read, and drains it
+// in the background so that no goroutine feeding it is left blocked sending.
+func cancelUpstream(o Observable) {
+	stopUpstream(o)
+	go func() {
+		for range o {
+		}
+	}()
+}
+
+// trySend sends an item to out unless quit is closed first, and reports
+// whether it has been sent.
+func trySend(out chan<- interface{}, item interface{}, quit <-chan struct{}) bool {
+	select {
+	case out <- item:
+		return true
+	case <-quit:
+		return false
+	}
+}
+
+// relay tracks the source currently read by an operator which moves from
+// source to source, such as Repeat or Catch, so that stopping its output
+// stops that source as well as the fixed ones.
+type relay struct {
+	mu      sync.Mutex
+	current Observable
+	quit    chan struct{}
+}
+
+// newRelay records that out is fed from the given sources, and from the
+// ones followed by the returned relay.
+func newRelay(out chan interface{}, sources ...Observable) *relay {
+	r := &relay{quit: make(chan struct{})}
+	var once sync.Once
+	onStop(out, func() {
+		once.Do(func() {
+			r.mu.Lock()
+			close(r.quit)
+			current := r.current
+			r.mu.Unlock()
+
+			for _, source := range sources {
+				stopUpstream(source)
+			}
+			if current != nil {
+				stopUpstream(current)
+			}
+		})
+	})
+	return r
+}
+
+// follow records source as the one now read, and reports whether out is
+// still running. Otherwise source is cancelled right away.
+func (r *relay) follow(source Observable) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	select {
+	case <-r.quit:
+		cancelUpstream(source)
+		return false
+	default:
+		r.current = source
+		return true
+	}
+}
+
+// Stoppable records that out is fed by a producer of another package, and
+// returns the quit channel closed once out is stopped, such as when a
+// Subscription to it is disposed of. The producer should then stop sending
+// and close out with CloseOut.
+func Stoppable(out chan interface{}) <-chan struct{} {
+	return stoppable(out)
+}
+
+// OnStop records the func stopping the goroutines feeding out, for the
+// producers of other packages which have more to stop than a quit channel,
+// such as a request to cancel.
+func OnStop(out Observable, fn func()) {
+	onStop(out, fn)
+}
+
+// Link records that out is fed from the given sources, so that stopping out,
+// such as when a Subscription to it is disposed of, stops them in turn. It is
+// meant for the operators of other packages, which close out with CloseOut.
+func Link(out chan interface{}, sources ...Observable) {
+	link(out, sources...)
+}
+
+// CloseOut forgets how to stop out, recorded by Stoppable, OnStop or Link,
+// and closes it.
+func CloseOut(out chan interface{}) {
+	closeOut(out)
+}
+
+// CancelUpstream stops an Observable which is no longer read, and drains it
+// in the background so that no goroutine feeding it is left blocked sending.
+func CancelUpstream(o Observable) {
+	cancelUpstream(o)
+}
@@ -0,0 +1,179 @@
+package observable
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitClosed reports whether o completes within a second, draining it.
+func waitClosed(o Observable) bool {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-o:
+			if !ok {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+// assertStopped subscribes to the Observable made by create, disposes of the
+// Subscription and asserts that every goroutine started meanwhile exits.
+func assertStopped(t *testing.T, name string, create func() Observable) {
+	before := runtime.NumGoroutine()
+	sub, subs := create().SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+	<-time.After(5 * time.Millisecond)
+	sub.Dispose()
+	<-subs
+
+	deadline := time.After(time.Second)
+	for runtime.NumGoroutine() > before {
+		select {
+		case <-deadline:
+			assert.Fail(t, "goroutines left after Dispose", "%s: %d instead of %d",
+				name, runtime.NumGoroutine(), before)
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestDisposeStopsGoroutines(t *testing.T) {
+	myerr := errors.New("bang")
+	ticking := func() Observable {
+		return Interval(nil, time.Millisecond)
+	}
+	failing := func() Observable {
+		return Throw(myerr)
+	}
+	reconnecting := func(factory func() Observable, policy BackoffPolicy) Observable {
+		myStream, states := Reconnecting(nil, factory, policy)
+		go drain(states)
+		return myStream
+	}
+
+	assertStopped(t, "Never", Never)
+	assertStopped(t, "RetryWhen", func() Observable {
+		return Factory(ticking).RetryWhen(func(error, int) bool {
+			return true
+		})
+	})
+	assertStopped(t, "RetryBackoff", func() Observable {
+		return Factory(failing).RetryBackoff(ConstantBackoff{Delay: time.Hour})
+	})
+	assertStopped(t, "Catch", func() Observable {
+		return Throw(myerr).Catch(func(error) Observable {
+			return ticking()
+		})
+	})
+	assertStopped(t, "Reconnecting", func() Observable {
+		return reconnecting(ticking, ConstantBackoff{})
+	})
+	assertStopped(t, "Reconnecting backoff", func() Observable {
+		return reconnecting(Empty, ConstantBackoff{Delay: time.Hour})
+	})
+}
+
+func TestStopUpstreamFanOut(t *testing.T) {
+	noop := handlers.NextFunc(func(interface{}) {})
+	key := func(item interface{}) interface{} {
+		return item.(int) % 2
+	}
+
+	source := Interval(nil, time.Millisecond)
+	sub, subs := source.GroupBy(key).SubscribeDisposable(noop)
+	sub.Dispose()
+	<-subs
+	assert.True(t, waitClosed(source), "GroupBy")
+
+	source = Interval(nil, time.Millisecond)
+	sub, subs = source.WindowWithCount(2).SubscribeDisposable(noop)
+	sub.Dispose()
+	<-subs
+	assert.True(t, waitClosed(source), "WindowWithCount")
+
+	// Broadcast stops its source once every branch is stopped.
+	source = Interval(nil, time.Millisecond)
+	branches := source.Broadcast(2, 1, OverflowDropNewest)
+	first, firstSubs := branches[0].SubscribeDisposable(noop)
+	first.Dispose()
+	<-firstSubs
+	<-time.After(5 * time.Millisecond)
+	_, ok := <-branches[1]
+	assert.True(t, ok)
+
+	second, secondSubs := branches[1].SubscribeDisposable(noop)
+	second.Dispose()
+	<-secondSubs
+	assert.True(t, waitClosed(source), "Broadcast")
+}
+
+func TestStopUnbounded(t *testing.T) {
+	in, myStream := Unbounded()
+	in <- 1
+	stopUpstream(myStream)
+
+	// The items sent once stopped are discarded.
+	in <- 2
+	in <- 3
+	close(in)
+	assert.True(t, waitClosed(myStream))
+}
+
+func TestStopUpstream(t *testing.T) {
+	producers := map[string]Observable{
+		"FromChannel": FromChannel(make(chan interface{})),
+		"Repeat":      Repeat(1),
+		"Range":       Range(0, 1000000),
+		"Just":        Just(1, 2, 3),
+		"Interval":    Interval(nil, time.Millisecond),
+	}
+	for name, producer := range producers {
+		chain := producer.Map(func(item interface{}) interface{} {
+			return item
+		}).Filter(func(interface{}) bool {
+			return true
+		})
+
+		sub, subs := chain.SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+		sub.Dispose()
+		<-subs
+		assert.True(t, waitClosed(producer), name)
+	}
+}
+
+func TestStopUpstreamForgetsCompleted(t *testing.T) {
+	myStream := Just(1, 2).Map(func(item interface{}) interface{} {
+		return item
+	})
+	drain(myStream)
+
+	upstream.Lock()
+	_, ok := upstream.stops[myStream]
+	upstream.Unlock()
+	assert.False(t, ok)
+
+	// Stopping a completed or foreign Observable does nothing.
+	stopUpstream(myStream)
+	stopUpstream(Observable(make(chan interface{})))
+}
+
+func TestStopUpstreamMultipleSources(t *testing.T) {
+	a := Interval(nil, time.Millisecond)
+	b := Interval(nil, time.Millisecond)
+
+	sub, subs := Merge(a, b).SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+	sub.Dispose()
+	<-subs
+	assert.True(t, waitClosed(a))
+	assert.True(t, waitClosed(b))
+}
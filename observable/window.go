@@ -0,0 +1,95 @@
+package observable
+
+import (
+	"time"
+)
+
+// WindowWithCount splits the items in the original Observable into windows of
+// count items, and returns a new Observable emitting each window as an
+// Observable when its first item arrives. Windows are queued without bound so
+// that a window which is not consumed never stalls the others. An error is
+// passed on to both the current window and the new Observable.
+func (o Observable) WindowWithCount(count int) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	if count < 1 {
+		count = 1
+	}
+	go func() {
+		var window chan<- interface{}
+		size := 0
+
+	OuterLoop:
+		for item := range o {
+			if window == nil {
+				var w Observable
+				window, w = Unbounded()
+				out <- w
+			}
+			window <- item
+			size++
+
+			if _, isErr := item.(error); isErr {
+				out <- item
+				break OuterLoop
+			}
+			if size >= count {
+				close(window)
+				window, size = nil, 0
+			}
+		}
+		if window != nil {
+			close(window)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
+
+// WindowWithTime splits the items in the original Observable into windows
+// spanning the given duration, and returns a new Observable emitting each
+// window as an Observable when its first item arrives. Empty windows are not
+// emitted. Windows are queued without bound so that a window which is not
+// consumed never stalls the others. An error is passed on to both the current
+// window and the new Observable.
+func (o Observable) WindowWithTime(d time.Duration) Observable {
+	out := make(chan interface{})
+	link(out, o)
+	clock := CurrentClock()
+	go func() {
+		tick := clock.After(d)
+		var window chan<- interface{}
+
+	OuterLoop:
+		for {
+			select {
+			case item, ok := <-o:
+				if !ok {
+					break OuterLoop
+				}
+				if window == nil {
+					var w Observable
+					window, w = Unbounded()
+					out <- w
+				}
+				window <- item
+
+				if _, isErr := item.(error); isErr {
+					out <- item
+					break OuterLoop
+				}
+			case <-tick:
+				tick = clock.After(d)
+				if window != nil {
+					close(window)
+					window = nil
+				}
+			}
+		}
+		if window != nil {
+			close(window)
+		}
+		closeOut(out)
+	}()
+	return Observable(out)
+}
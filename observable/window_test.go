@@ -0,0 +1,51 @@
+package observable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowWithCount(t *testing.T) {
+	windows := []Observable{}
+	for window := range Just(1, 2, 3, 4, 5).WindowWithCount(2) {
+		windows = append(windows, window.(Observable))
+	}
+
+	assert.Len(t, windows, 3)
+	assert.Exactly(t, []interface{}{1, 2}, drain(windows[0]))
+	assert.Exactly(t, []interface{}{3, 4}, drain(windows[1]))
+	assert.Exactly(t, []interface{}{5}, drain(windows[2]))
+}
+
+func TestWindowWithCountWithError(t *testing.T) {
+	myerr := errors.New("bang")
+	items := []interface{}{}
+	for item := range Just(1, 2, 3, myerr).WindowWithCount(2) {
+		items = append(items, item)
+	}
+
+	assert.Len(t, items, 3)
+	assert.Exactly(t, []interface{}{1, 2}, drain(items[0].(Observable)))
+	assert.Exactly(t, []interface{}{3, myerr}, drain(items[1].(Observable)))
+	assert.Equal(t, myerr, items[2])
+}
+
+func TestWindowWithTime(t *testing.T) {
+	source := make(chan interface{})
+	myStream := Observable(source).WindowWithTime(30 * time.Millisecond)
+
+	source <- 1
+	first := (<-myStream).(Observable)
+	source <- 2
+	assert.Exactly(t, []interface{}{1, 2}, drain(first))
+
+	source <- 3
+	second := (<-myStream).(Observable)
+	close(source)
+	assert.Exactly(t, []interface{}{3}, drain(second))
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
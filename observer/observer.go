@@ -1,53 +1,138 @@
 package observer
 
 import (
+	"sync"
+
 	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/errors"
 	"github.com/reactivex/rxgo/handlers"
 )
 
-// Observer represents a group of EventHandlers.
+// Observer represents a group of EventHandlers. An Observer built by New or
+// Guard drops any call made once OnError or OnDone has been called.
 type Observer struct {
 	NextHandler handlers.NextFunc
 	ErrHandler  handlers.ErrFunc
 	DoneHandler handlers.DoneFunc
+
+	gate *gate
+}
+
+// gate records whether an Observer has terminated, and is shared by its
+// copies.
+type gate struct {
+	mu          sync.Mutex
+	terminated  bool
+	onViolation func(error)
+}
+
+// pass reports whether a call may go through, terminating the Observer if
+// terminal is set. A nil gate lets every call through.
+func (g *gate) pass(call string, terminal bool) bool {
+	if g == nil {
+		return true
+	}
+	g.mu.Lock()
+	ok := !g.terminated
+	if terminal {
+		g.terminated = true
+	}
+	g.mu.Unlock()
+	if !ok && g.onViolation != nil {
+		g.onViolation(errors.New(errors.ObserverError, call+" called after termination"))
+	}
+	return ok
 }
 
-// DefaultObserver guarantees any handler won't be nil.
+// DefaultObserver guarantees any handler won't be nil. Its ErrHandler passes
+// the error on to the unhandled error handler.
 var DefaultObserver = Observer{
 	NextHandler: func(interface{}) {},
-	ErrHandler:  func(err error) {},
+	ErrHandler:  unhandledError,
 	DoneHandler: func() {},
 }
 
+var (
+	unhandledMu           sync.RWMutex
+	unhandledErrorHandler func(error)
+)
+
+// SetUnhandledErrorHandler sets the func called with an error delivered to an
+// Observer without an ErrHandler of its own. A nil func, the default, drops
+// such errors.
+func SetUnhandledErrorHandler(fn func(error)) {
+	unhandledMu.Lock()
+	unhandledErrorHandler = fn
+	unhandledMu.Unlock()
+}
+
+func unhandledError(err error) {
+	unhandledMu.RLock()
+	fn := unhandledErrorHandler
+	unhandledMu.RUnlock()
+	if fn != nil {
+		fn(err)
+	}
+}
+
 // Handle registers Observer to EventHandler.
 func (ob Observer) Handle(item interface{}) {
 	switch item := item.(type) {
 	case error:
-		ob.ErrHandler(item)
+		ob.OnError(item)
 		return
 	default:
-		ob.NextHandler(item)
+		ob.OnNext(item)
 	}
 }
 
+// Option configures an Observer built by New. Every EventHandler is an
+// Option, setting the handler of its kind, while an Observer replaces all of
+// them.
+type Option rx.EventHandler
+
+// WithNextHandler sets the NextHandler of an Observer built by New.
+func WithNextHandler(fn func(interface{})) Option {
+	return handlers.NextFunc(fn)
+}
+
+// WithErrHandler sets the ErrHandler of an Observer built by New.
+func WithErrHandler(fn func(error)) Option {
+	return handlers.ErrFunc(fn)
+}
+
+// WithDoneHandler sets the DoneHandler of an Observer built by New.
+func WithDoneHandler(fn func()) Option {
+	return handlers.DoneFunc(fn)
+}
+
 // New constructs a new Observer instance with default Observer and accept
-// any number of EventHandler
-func New(eventHandlers ...rx.EventHandler) Observer {
+// any number of Option, such as EventHandlers. Nil handlers leave the
+// default ones in place. The Observer drops any call made once it has
+// terminated.
+func New(opts ...Option) Observer {
 	ob := DefaultObserver
-	if len(eventHandlers) > 0 {
-		for _, handler := range eventHandlers {
-			switch handler := handler.(type) {
-			case handlers.NextFunc:
-				ob.NextHandler = handler
-			case handlers.ErrFunc:
-				ob.ErrHandler = handler
-			case handlers.DoneFunc:
-				ob.DoneHandler = handler
-			case Observer:
-				ob = handler
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case handlers.NextFunc:
+			if opt != nil {
+				ob.NextHandler = opt
+			}
+		case handlers.ErrFunc:
+			if opt != nil {
+				ob.ErrHandler = opt
 			}
+		case handlers.DoneFunc:
+			if opt != nil {
+				ob.DoneHandler = opt
+			}
+		case Observer:
+			ob = opt
 		}
 	}
+	if ob.gate == nil {
+		ob.gate = &gate{}
+	}
 	return ob
 }
 
@@ -57,6 +142,9 @@ func (ob Observer) OnNext(item interface{}) {
 	case error:
 		return
 	default:
+		if !ob.gate.pass("OnNext", false) {
+			return
+		}
 		if ob.NextHandler != nil {
 			ob.NextHandler(item)
 		}
@@ -65,14 +153,56 @@ func (ob Observer) OnNext(item interface{}) {
 
 // OnError applies Observer's ErrHandler to an error
 func (ob Observer) OnError(err error) {
+	if !ob.gate.pass("OnError", true) {
+		return
+	}
 	if ob.ErrHandler != nil {
 		ob.ErrHandler(err)
+	} else {
+		unhandledError(err)
 	}
 }
 
 // OnDone terminates the Observer's internal Observable
 func (ob Observer) OnDone() {
+	if !ob.gate.pass("OnDone", true) {
+		return
+	}
 	if ob.DoneHandler != nil {
 		ob.DoneHandler()
 	}
 }
+
+// Serialize returns an Observer calling the handlers of ob one at a time, so
+// that it can be handed to producers calling it from several goroutines at
+// once. Subscribing to an Observable already delivers items sequentially.
+func Serialize(ob Observer) Observer {
+	var mu sync.Mutex
+	return Observer{
+		NextHandler: func(item interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			ob.OnNext(item)
+		},
+		ErrHandler: func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			ob.OnError(err)
+		},
+		DoneHandler: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			ob.OnDone()
+		},
+	}
+}
+
+// Guard returns a copy of ob enforcing the contract of an Observer for
+// producers which call it directly: once OnError or OnDone has been called,
+// any later call is dropped. Unlike New, it also applies to an Observer built
+// as a literal. In strict mode, a non-nil onViolation is called with an
+// ObserverError for each dropped call.
+func Guard(ob Observer, onViolation func(error)) Observer {
+	ob.gate = &gate{onViolation: onViolation}
+	return ob
+}
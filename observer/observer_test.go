@@ -1,6 +1,8 @@
 package observer
 
 import (
+	"errors"
+	"sync"
 	"testing"
 
 	"github.com/reactivex/rxgo/handlers"
@@ -48,3 +50,134 @@ func TestCreateNewObserverWithObserver(t *testing.T) {
 	assert.Equal(t, "Next", nexttext)
 	assert.Equal(t, "Hello", donetext)
 }
+
+func TestUnhandledErrorHandler(t *testing.T) {
+	var unhandled []error
+	SetUnhandledErrorHandler(func(err error) {
+		unhandled = append(unhandled, err)
+	})
+	defer SetUnhandledErrorHandler(nil)
+
+	myerr := errors.New("bang")
+	New().OnError(myerr)
+	Observer{}.OnError(myerr)
+	New(handlers.ErrFunc(func(err error) {})).OnError(myerr)
+
+	assert.Exactly(t, []error{myerr, myerr}, unhandled)
+}
+
+func TestCreateNewObserverWithOptions(t *testing.T) {
+	nexttext := ""
+	var err error
+
+	ob := New(
+		WithNextHandler(func(item interface{}) {
+			nexttext = item.(string)
+		}),
+		WithErrHandler(func(e error) {
+			err = e
+		}),
+		WithDoneHandler(nil),
+	)
+
+	myerr := errors.New("bang")
+	ob.OnNext("Next")
+	ob.OnError(myerr)
+	ob.OnDone()
+
+	assert.Equal(t, "Next", nexttext)
+	assert.Equal(t, myerr, err)
+	assert.NotNil(t, ob.DoneHandler)
+}
+
+func TestSerialize(t *testing.T) {
+	active, peak, count := 0, 0, 0
+	ob := Serialize(New(WithNextHandler(func(item interface{}) {
+		active++
+		if active > peak {
+			peak = active
+		}
+		count++
+		active--
+	})))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ob.OnNext(j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, peak)
+	assert.Equal(t, 800, count)
+}
+
+func TestObserverTerminates(t *testing.T) {
+	items := []interface{}{}
+	errs := 0
+	ob := New(
+		WithNextHandler(func(item interface{}) {
+			items = append(items, item)
+		}),
+		WithErrHandler(func(error) {
+			errs++
+		}),
+	)
+
+	ob.OnNext(1)
+	ob.Handle(errors.New("bang"))
+	ob.OnNext(2)
+	ob.Handle(3)
+	ob.OnError(errors.New("bang"))
+	ob.OnDone()
+
+	assert.Exactly(t, []interface{}{1}, items)
+	assert.Equal(t, 1, errs)
+
+	// Copies share the state of the Observer, while New keeps it.
+	copied := New(ob)
+	copied.OnNext(4)
+	assert.Exactly(t, []interface{}{1}, items)
+}
+
+func TestGuard(t *testing.T) {
+	items := []interface{}{}
+	dones := 0
+	ob := New(
+		WithNextHandler(func(item interface{}) {
+			items = append(items, item)
+		}),
+		WithDoneHandler(func() {
+			dones++
+		}),
+	)
+
+	guarded := Guard(ob, nil)
+	guarded.OnNext(1)
+	guarded.OnDone()
+	guarded.OnNext(2)
+	guarded.OnDone()
+	guarded.OnError(errors.New("bang"))
+
+	assert.Exactly(t, []interface{}{1}, items)
+	assert.Equal(t, 1, dones)
+
+	violations := []error{}
+	strict := Guard(ob, func(err error) {
+		violations = append(violations, err)
+	})
+	strict.OnError(errors.New("bang"))
+	strict.OnNext(3)
+	strict.OnDone()
+
+	assert.Exactly(t, []interface{}{1}, items)
+	if assert.Len(t, violations, 2) {
+		assert.EqualError(t, violations[0], "4 - OnNext called after termination")
+		assert.EqualError(t, violations[1], "4 - OnDone called after termination")
+	}
+}
@@ -0,0 +1,176 @@
+// Package pipeline provides a builder of named multi-stage pipelines, which
+// route the errors of their stages and start and stop as a unit.
+package pipeline
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/reactivex/rxgo/observable"
+)
+
+// StageError is an error emitted by a named stage.
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+// Error returns an error string to implement the error interface.
+func (err StageError) Error() string {
+	return fmt.Sprintf("stage %s: %v", err.Stage, err.Err)
+}
+
+// Unwrap returns the error emitted by the stage.
+func (err StageError) Unwrap() error {
+	return err.Err
+}
+
+type stage struct {
+	name   string
+	op     observable.Operator
+	errors chan<- interface{}
+}
+
+// Pipeline is a chain of named stages.
+type Pipeline struct {
+	mu      sync.Mutex
+	stages  []*stage
+	errors  chan<- interface{}
+	shared  observable.Observable
+	running bool
+	term    chan struct{}
+}
+
+// New creates a Pipeline with no stage.
+func New() *Pipeline {
+	errors, shared := observable.Unbounded()
+	return &Pipeline{
+		errors: errors,
+		shared: shared,
+		term:   make(chan struct{}),
+	}
+}
+
+// Stage appends a stage created by an Operator to the Pipeline.
+func (p *Pipeline) Stage(name string, op observable.Operator) *Pipeline {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stages = append(p.stages, &stage{name: name, op: op})
+	return p
+}
+
+// Errors returns an Observable of the StageErrors of every stage whose errors
+// are not routed elsewhere. It completes once the Pipeline has terminated.
+func (p *Pipeline) Errors() observable.Observable {
+	return p.shared
+}
+
+// ErrorsOf routes the errors of the named stage to a dedicated Observable of
+// StageErrors, which completes once the Pipeline has terminated. It returns
+// nil if there is no such stage or if its errors are already routed. The
+// routes are set up by Run, so ErrorsOf must be called before it.
+func (p *Pipeline) ErrorsOf(name string) observable.Observable {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running {
+		panic("pipeline: ErrorsOf called after Run")
+	}
+	for _, s := range p.stages {
+		if s.name == name && s.errors == nil {
+			errors, routed := observable.Unbounded()
+			s.errors = errors
+			return routed
+		}
+	}
+	return nil
+}
+
+// Run feeds source through every stage in order and returns the Observable
+// emitted by the last stage. Errors emitted by a stage are routed rather than
+// passed on, and the Pipeline runs until source completes or Stop is called.
+// A Pipeline can only be run once.
+func (p *Pipeline) Run(source observable.Observable) observable.Observable {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running {
+		panic("pipeline: Run called twice")
+	}
+	p.running = true
+
+	gate := make(chan interface{})
+	go func() {
+	OuterLoop:
+		for {
+			select {
+			case <-p.term:
+				break OuterLoop
+			case item, ok := <-source:
+				if !ok {
+					break OuterLoop
+				}
+				select {
+				case gate <- item:
+				case <-p.term:
+					break OuterLoop
+				}
+			}
+		}
+		close(gate)
+	}()
+
+	stream := observable.Observable(gate)
+	for _, s := range p.stages {
+		stream = p.route(s, s.op(stream))
+	}
+
+	out := make(chan interface{})
+	// Disposing of a Subscription to the output stops the Pipeline.
+	observable.OnStop(out, p.Stop)
+	go func() {
+		for item := range stream {
+			out <- item
+		}
+		for _, s := range p.stages {
+			if s.errors != nil {
+				close(s.errors)
+			}
+		}
+		close(p.errors)
+		observable.CloseOut(out)
+	}()
+	return observable.Observable(out)
+}
+
+// Stop stops feeding the source to the Pipeline, which lets every stage
+// terminate in order once it has processed the items it has received.
+func (p *Pipeline) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-p.term:
+	default:
+		close(p.term)
+	}
+}
+
+// route passes on the items of a stage, and its errors to their route.
+func (p *Pipeline) route(s *stage, o observable.Observable) observable.Observable {
+	errors := s.errors
+	if errors == nil {
+		errors = p.errors
+	}
+
+	out := make(chan interface{})
+	observable.Link(out, o)
+	go func() {
+		for item := range o {
+			if err, isErr := item.(error); isErr {
+				errors <- StageError{Stage: s.name, Err: err}
+				continue
+			}
+			out <- item
+		}
+		observable.CloseOut(out)
+	}()
+	return observable.Observable(out)
+}
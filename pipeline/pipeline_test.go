@@ -0,0 +1,99 @@
+package pipeline
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reactivex/rxgo/observable"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect(o observable.Observable) <-chan []interface{} {
+	items := make(chan []interface{})
+	go func() {
+		collected := []interface{}{}
+		for item := range o {
+			collected = append(collected, item)
+		}
+		items <- collected
+	}()
+	return items
+}
+
+func TestPipeline(t *testing.T) {
+	parse := func(o observable.Observable) observable.Observable {
+		return o.Map(func(item interface{}) interface{} {
+			if item.(int) < 0 {
+				return errors.New("negative")
+			}
+			return item
+		})
+	}
+
+	double := func(o observable.Observable) observable.Observable {
+		return o.Map(func(item interface{}) interface{} {
+			if item.(int) == 0 {
+				return errors.New("zero")
+			}
+			return item.(int) * 2
+		})
+	}
+
+	p := New().Stage("parse", parse).Stage("double", double)
+	parseErrors := collect(p.ErrorsOf("parse"))
+	sharedErrors := collect(p.Errors())
+	assert.Nil(t, p.ErrorsOf("unknown"))
+
+	items := collect(p.Run(observable.Just(1, -1, 0, 2)))
+
+	assert.Exactly(t, []interface{}{2, 4}, <-items)
+	assert.Exactly(t, []interface{}{
+		StageError{Stage: "parse", Err: errors.New("negative")},
+	}, <-parseErrors)
+	assert.Exactly(t, []interface{}{
+		StageError{Stage: "double", Err: errors.New("zero")},
+	}, <-sharedErrors)
+}
+
+func TestPipelineStop(t *testing.T) {
+	source := make(chan interface{})
+	p := New().Stage("identity", func(o observable.Observable) observable.Observable {
+		return o
+	})
+
+	myStream := p.Run(observable.Observable(source))
+	source <- 1
+	assert.Equal(t, 1, <-myStream)
+
+	p.Stop()
+	p.Stop()
+	_, ok := <-myStream
+	assert.False(t, ok)
+
+	_, ok = <-p.Errors()
+	assert.False(t, ok)
+}
+
+func TestStageError(t *testing.T) {
+	err := StageError{Stage: "parse", Err: errors.New("bang")}
+	assert.Equal(t, "stage parse: bang", err.Error())
+
+	var myerr error = err
+	assert.Equal(t, errors.New("bang"), errors.Unwrap(myerr))
+}
+
+func TestPipelineErrorsOfAfterRun(t *testing.T) {
+	p := New().Stage("identity", func(o observable.Observable) observable.Observable {
+		return o
+	})
+	routed := collect(p.ErrorsOf("identity"))
+	p.Run(observable.Just(errors.New("bang")))
+
+	assert.Panics(t, func() {
+		p.ErrorsOf("identity")
+	})
+	assert.Exactly(t, []interface{}{
+		StageError{Stage: "identity", Err: errors.New("bang")},
+	}, <-routed)
+}
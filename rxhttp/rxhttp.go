@@ -0,0 +1,215 @@
+// Package rxhttp provides Observables over HTTP requests and responses.
+package rxhttp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+)
+
+// Response is a payload fetched over HTTP.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// PollOptions configures the Poll source.
+type PollOptions struct {
+	// Client sends the requests. It defaults to http.DefaultClient.
+	Client *http.Client
+
+	// Header is added to every request.
+	Header http.Header
+
+	// Interval is the time between two requests. It defaults to one minute.
+	Interval time.Duration
+
+	// MaxInterval bounds the interval, which doubles after every unchanged
+	// response and is reset once the payload changes. It defaults to
+	// Interval, which disables the adaptation.
+	MaxInterval time.Duration
+
+	// Term stops the polling when closed, cancelling the request in flight.
+	Term chan struct{}
+}
+
+// Poll creates an Observable which requests url every Interval and emits a
+// Response only when its payload has changed. Requests carry If-None-Match
+// and If-Modified-Since headers from the last Response, so that the server
+// can answer 304 Not Modified instead of sending the same payload again. A
+// server ignoring them is detected as well: a 200 response with the same ETag
+// or the same body as the last Response is not emitted. The interval is timed
+// by the observable Clock.
+// A failed request or an unexpected status emits an error and terminates
+// the Observable. Closing Term or disposing of a Subscription to the
+// Observable cancels the request in flight and completes the Observable.
+func Poll(url string, opts PollOptions) observable.Observable {
+	source := make(chan interface{})
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Minute
+	}
+	if opts.MaxInterval < opts.Interval {
+		opts.MaxInterval = opts.Interval
+	}
+
+	clock := observable.CurrentClock()
+
+	// Closing Term or disposing of a Subscription cancels the request in
+	// flight as well as the polling.
+	ctx, cancel := context.WithCancel(context.Background())
+	observable.OnStop(source, cancel)
+	go func() {
+		select {
+		case <-opts.Term:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	go func() {
+		defer observable.CloseOut(source)
+		defer cancel()
+
+		// emit reports whether the item has been sent before the polling
+		// was cancelled.
+		emit := func(item interface{}) bool {
+			select {
+			case source <- item:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var etag, lastModified string
+		var sum []byte
+		interval := opts.Interval
+		for {
+			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+			if err != nil {
+				emit(errors.Wrap(errors.ObservableError, err, "invalid request"))
+				return
+			}
+			for key, values := range opts.Header {
+				req.Header[key] = values
+			}
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				req.Header.Set("If-Modified-Since", lastModified)
+			}
+
+			res, err := fetch(opts.Client, req)
+			if err != nil {
+				if ctx.Err() == nil {
+					emit(err)
+				}
+				return
+			}
+
+			var resSum []byte
+			unchanged := res.StatusCode == http.StatusNotModified
+			if !unchanged {
+				hash := sha256.Sum256(res.Body)
+				resSum = hash[:]
+				unchanged = etag != "" && res.Header.Get("ETag") == etag ||
+					bytes.Equal(resSum, sum)
+			}
+
+			if unchanged {
+				interval *= 2
+				if interval > opts.MaxInterval {
+					interval = opts.MaxInterval
+				}
+			} else {
+				etag = res.Header.Get("ETag")
+				lastModified = res.Header.Get("Last-Modified")
+				sum = resSum
+				interval = opts.Interval
+
+				if !emit(res) {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-clock.After(interval):
+			}
+		}
+	}()
+	return observable.Observable(source)
+}
+
+// Do creates a Single which sends a request with the client, or
+// http.DefaultClient if nil, and emits the whole Response. A failed request
+// or a status other than 2xx emits an error. Disposing of a Subscription to
+// the Single cancels the request.
+func Do(client *http.Client, req *http.Request) observable.Single {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+
+	out := make(chan interface{}, 1)
+	observable.OnStop(out, cancel)
+	go func() {
+		defer cancel()
+		res, err := fetch(client, req)
+		if err != nil {
+			out <- err
+		} else {
+			out <- res
+		}
+		observable.CloseOut(out)
+	}()
+	return observable.Single(out)
+}
+
+// fetch sends a request and reads the whole Response.
+func fetch(client *http.Client, req *http.Request) (*Response, error) {
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 && res.StatusCode != http.StatusNotModified {
+		msg := fmt.Sprintf("unexpected status %s", res.Status)
+		return nil, errors.New(errors.ObservableError, msg)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	return &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}, nil
+}
+
+// requestError wraps the error of a request in a TimeoutError if it has timed
+// out, and in an ObservableError otherwise.
+func requestError(err error) error {
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return errors.Wrap(errors.TimeoutError, err, "request timed out")
+	}
+	return errors.Wrap(errors.ObservableError, err, "request failed")
+}
@@ -0,0 +1,250 @@
+package rxhttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	observable.SetClock(s)
+	defer observable.SetClock(nil)
+
+	var mu sync.Mutex
+	version := "v1"
+	conditional := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == version {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", version)
+		w.Write([]byte("payload " + version))
+	}))
+	defer server.Close()
+
+	requests := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(conditional)
+	}
+
+	term := make(chan struct{})
+	myStream := Poll(server.URL, PollOptions{
+		Interval:    time.Second,
+		MaxInterval: 4 * time.Second,
+		Term:        term,
+	})
+
+	res := (<-myStream).(*Response)
+	assert.Equal(t, "payload v1", string(res.Body))
+
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Second)
+	s.BlockUntil(1)
+	assert.Equal(t, 2, requests())
+
+	// Unchanged responses slow the polling down.
+	s.AdvanceBy(time.Second)
+	assert.Equal(t, 2, requests())
+	s.AdvanceBy(time.Second)
+	s.BlockUntil(1)
+	assert.Equal(t, 3, requests())
+
+	mu.Lock()
+	version = "v2"
+	mu.Unlock()
+	s.AdvanceBy(4 * time.Second)
+
+	res = (<-myStream).(*Response)
+	assert.Equal(t, "payload v2", string(res.Body))
+	close(term)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "v1", "v1", "v1"}, conditional)
+}
+
+func TestPollUnchangedPayload(t *testing.T) {
+	s := scheduler.NewTestScheduler()
+	observable.SetClock(s)
+	defer observable.SetClock(nil)
+
+	var mu sync.Mutex
+	etag, body := "v1", "payload v1"
+
+	// The server ignores the conditional headers.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	term := make(chan struct{})
+	defer close(term)
+	myStream := Poll(server.URL, PollOptions{
+		Interval: time.Second,
+		Term:     term,
+	})
+
+	res := (<-myStream).(*Response)
+	assert.Equal(t, "payload v1", string(res.Body))
+
+	// assertSuppressed polls once, and asserts nothing has been emitted.
+	assertSuppressed := func() {
+		s.BlockUntil(1)
+		s.AdvanceBy(time.Second)
+		s.BlockUntil(1)
+		select {
+		case item := <-myStream:
+			assert.Fail(t, "unexpected item", item)
+		default:
+		}
+	}
+
+	// The same ETag is not emitted, whatever the body.
+	mu.Lock()
+	body = "payload v1 again"
+	mu.Unlock()
+	assertSuppressed()
+
+	// Without an ETag, the same body is not emitted.
+	mu.Lock()
+	etag, body = "", "payload v2"
+	mu.Unlock()
+	s.AdvanceBy(time.Second)
+	res = (<-myStream).(*Response)
+	assert.Equal(t, "payload v2", string(res.Body))
+	assertSuppressed()
+}
+
+func TestDoDispose(t *testing.T) {
+	arrived := make(chan struct{})
+	cancelled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(arrived)
+		<-r.Context().Done()
+		close(cancelled)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	sub, subs := Do(nil, req).Observable().SubscribeDisposable(handlers.NextFunc(func(interface{}) {}))
+	<-arrived
+	sub.Dispose()
+	<-subs
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		assert.Fail(t, "request not cancelled after Dispose")
+	}
+}
+
+func TestPollCancelled(t *testing.T) {
+	for _, dispose := range []bool{false, true} {
+		arrived := make(chan struct{})
+		cancelled := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(arrived)
+			<-r.Context().Done()
+			close(cancelled)
+		}))
+
+		term := make(chan struct{})
+		items := 0
+		sub, subs := Poll(server.URL, PollOptions{Term: term}).SubscribeDisposable(handlers.NextFunc(func(interface{}) {
+			items++
+		}))
+		<-arrived
+		if dispose {
+			sub.Dispose()
+		} else {
+			close(term)
+		}
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			assert.Fail(t, "request not cancelled", "dispose: %v", dispose)
+		}
+		assert.Nil(t, (<-subs).Err())
+		assert.Equal(t, 0, items)
+		server.Close()
+	}
+}
+
+func TestPollWithUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	myStream := Poll(server.URL, PollOptions{})
+	err, isErr := (<-myStream).(error)
+	if assert.True(t, isErr) {
+		assert.Contains(t, err.Error(), "404")
+	}
+
+	_, ok := <-myStream
+	assert.False(t, ok)
+}
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	item, err := Do(nil, req).Get()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "payload", string(item.(*Response).Body))
+	}
+
+	created := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer created.Close()
+
+	req, _ = http.NewRequest("POST", created.URL, nil)
+	item, err = Do(nil, req).Get()
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusCreated, item.(*Response).StatusCode)
+	}
+
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+
+	req, _ = http.NewRequest("GET", missing.URL, nil)
+	_, err = Do(nil, req).Get()
+	assert.Error(t, err)
+
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	req, _ = http.NewRequest("GET", slow.URL, nil)
+	_, err = Do(&http.Client{Timeout: 10 * time.Millisecond}, req).Get()
+	assert.True(t, errors.Is(err, rxerrors.New(rxerrors.TimeoutError)), err)
+}
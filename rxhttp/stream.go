@@ -0,0 +1,143 @@
+package rxhttp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+)
+
+// Event is a server-sent event read from a text/event-stream body.
+type Event struct {
+	ID   string
+	Type string
+	Data string
+}
+
+// Stream sends a request with the client, or http.DefaultClient if nil, and
+// emits its response body as it arrives: an *Event for each server-sent event
+// of a text/event-stream body, and a []byte for each chunk read of any other
+// body. A failed request, a status other than 2xx or a failed read emits an
+// error.
+//
+// Disposing of a Subscription to the Observable cancels the request and
+// completes the Observable.
+func Stream(client *http.Client, req *http.Request) observable.Observable {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+
+	source := make(chan interface{})
+	observable.OnStop(source, cancel)
+	go func() {
+		defer observable.CloseOut(source)
+		defer cancel()
+
+		// emit reports whether the item has been sent before the
+		// request was cancelled.
+		emit := func(item interface{}) bool {
+			select {
+			case source <- item:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() == nil {
+				emit(requestError(err))
+			}
+			return
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode/100 != 2 {
+			msg := fmt.Sprintf("unexpected status %s", res.Status)
+			emit(errors.New(errors.ObservableError, msg))
+			return
+		}
+
+		if strings.HasPrefix(res.Header.Get("Content-Type"), "text/event-stream") {
+			err = readEvents(bufio.NewReader(res.Body), emit)
+		} else {
+			err = readChunks(res.Body, emit)
+		}
+		if err != nil && ctx.Err() == nil {
+			emit(requestError(err))
+		}
+	}()
+	return observable.Observable(source)
+}
+
+// readChunks emits a copy of each chunk read until the end of the body.
+func readChunks(r io.Reader, emit func(interface{}) bool) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			chunk := make([]byte, n)
+			copy(chunk, buf[:n])
+			if !emit(chunk) {
+				return nil
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// readEvents parses server-sent events and emits each one dispatched.
+func readEvents(r *bufio.Reader, emit func(interface{}) bool) error {
+	var event Event
+	var data []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil && line == "" {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if line == "" {
+			if len(data) > 0 {
+				event.Data = strings.Join(data, "\n")
+				if !emit(&Event{event.ID, event.Type, event.Data}) {
+					return nil
+				}
+			}
+			event.Type, data = "", nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			event.Type = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			event.ID = value
+		}
+	}
+}
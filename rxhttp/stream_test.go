@@ -0,0 +1,84 @@
+package rxhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte(": comment\n\nid: 1\nevent: greeting\ndata: hello\ndata: world\n\n"))
+		w.Write([]byte("id: 2\r\ndata:bye\r\n\r\n"))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	myStream := Stream(nil, req)
+
+	items := []interface{}{}
+	for item := range myStream {
+		items = append(items, item)
+	}
+	assert.Exactly(t, []interface{}{
+		&Event{ID: "1", Type: "greeting", Data: "hello\nworld"},
+		&Event{ID: "2", Data: "bye"},
+	}, items)
+}
+
+func TestStreamChunks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("chunked body"))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	myStream := Stream(nil, req)
+
+	body := []byte{}
+	for item := range myStream {
+		body = append(body, item.([]byte)...)
+	}
+	assert.Equal(t, "chunked body", string(body))
+}
+
+func TestStreamDispose(t *testing.T) {
+	closed := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("data: first\n\n"))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+		close(closed)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	events := make(chan interface{}, 1)
+	sub, subs := Stream(nil, req).SubscribeDisposable(handlers.NextFunc(func(item interface{}) {
+		events <- item
+	}))
+
+	assert.Equal(t, &Event{Data: "first"}, <-events)
+	sub.Dispose()
+	<-subs
+	<-closed
+}
+
+func TestStreamWithUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	myStream := Stream(nil, req)
+
+	err, isErr := (<-myStream).(error)
+	if assert.True(t, isErr) {
+		assert.Contains(t, err.Error(), "404")
+	}
+}
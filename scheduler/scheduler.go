@@ -0,0 +1,128 @@
+// Package scheduler provides Schedulers, which decide on which goroutine the
+// work of an Observable is run.
+package scheduler
+
+import (
+	"sync"
+	"time"
+)
+
+// Scheduler runs tasks.
+type Scheduler interface {
+	Schedule(task func())
+}
+
+type immediate struct{}
+
+// Schedule registers immediate to Scheduler.
+func (immediate) Schedule(task func()) {
+	task()
+}
+
+type newGoroutine struct{}
+
+// Schedule registers newGoroutine to Scheduler.
+func (newGoroutine) Schedule(task func()) {
+	go task()
+}
+
+// Clock tells the time to the time-based operators, which lets tests replace
+// it with a TestScheduler.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+// Now registers realClock to Clock.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// After registers realClock to Clock.
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+var (
+	// Immediate runs each task right away on the calling goroutine.
+	Immediate Scheduler = immediate{}
+
+	// NewGoroutine runs each task on a goroutine of its own.
+	NewGoroutine Scheduler = newGoroutine{}
+
+	// RealClock tells the wall-clock time.
+	RealClock Clock = realClock{}
+)
+
+// WorkerPool runs tasks on a fixed number of goroutines, in the order they
+// were scheduled. Tasks are queued without bound, so that scheduling never
+// waits for a worker.
+type WorkerPool struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queue   []func()
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// NewWorkerPool creates a WorkerPool of n workers, at least one.
+func NewWorkerPool(n int) *WorkerPool {
+	if n < 1 {
+		n = 1
+	}
+	p := &WorkerPool{}
+	p.cond = sync.NewCond(&p.mu)
+	p.wg.Add(n)
+	for i := 0; i < n; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// NewEventLoop creates a WorkerPool of a single worker, which runs every task
+// one after the other on the same goroutine.
+func NewEventLoop() *WorkerPool {
+	return NewWorkerPool(1)
+}
+
+// Schedule registers WorkerPool to Scheduler. Tasks scheduled after Stop are
+// ignored.
+func (p *WorkerPool) Schedule(task func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return
+	}
+	p.queue = append(p.queue, task)
+	p.cond.Signal()
+}
+
+// Stop waits for the queued tasks to complete and stops the workers.
+func (p *WorkerPool) Stop() {
+	p.mu.Lock()
+	p.stopped = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+func (p *WorkerPool) work() {
+	for {
+		p.mu.Lock()
+		for len(p.queue) == 0 && !p.stopped {
+			p.cond.Wait()
+		}
+		if len(p.queue) == 0 {
+			p.mu.Unlock()
+			break
+		}
+		task := p.queue[0]
+		p.queue = p.queue[1:]
+		p.mu.Unlock()
+
+		task()
+	}
+	p.wg.Done()
+}
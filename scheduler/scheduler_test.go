@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmediate(t *testing.T) {
+	ran := false
+	Immediate.Schedule(func() {
+		ran = true
+	})
+	assert.True(t, ran)
+}
+
+func TestNewGoroutine(t *testing.T) {
+	done := make(chan struct{})
+	NewGoroutine.Schedule(func() {
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "task never ran")
+	}
+}
+
+func TestEventLoop(t *testing.T) {
+	loop := NewEventLoop()
+	nums := []int{}
+	for i := 0; i < 100; i++ {
+		i := i
+		loop.Schedule(func() {
+			nums = append(nums, i)
+		})
+	}
+	loop.Stop()
+
+	assert.Len(t, nums, 100)
+	for i, num := range nums {
+		assert.Equal(t, i, num)
+	}
+}
+
+func TestWorkerPool(t *testing.T) {
+	pool := NewWorkerPool(3)
+
+	var mu sync.Mutex
+	active, peak, count := 0, 0, 0
+	for i := 0; i < 20; i++ {
+		pool.Schedule(func() {
+			mu.Lock()
+			active++
+			if active > peak {
+				peak = active
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			active--
+			count++
+			mu.Unlock()
+		})
+	}
+	pool.Stop()
+
+	assert.Equal(t, 20, count)
+	assert.True(t, peak <= 3, peak)
+
+	pool.Schedule(func() {
+		assert.Fail(t, "task ran after Stop")
+	})
+}
@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"sync"
+)
+
+// Trampoline runs tasks one after the other on the goroutine which scheduled
+// the first of them. A task scheduled while another one is running, such as
+// from within a recursive task, is queued and run once the current one
+// returns instead of growing the stack.
+type Trampoline struct {
+	mu      sync.Mutex
+	queue   []func()
+	running bool
+}
+
+// NewTrampoline creates an idle Trampoline.
+func NewTrampoline() *Trampoline {
+	return &Trampoline{}
+}
+
+// Schedule registers Trampoline to Scheduler.
+func (t *Trampoline) Schedule(task func()) {
+	t.mu.Lock()
+	t.queue = append(t.queue, task)
+	if t.running {
+		t.mu.Unlock()
+		return
+	}
+	t.running = true
+
+	for len(t.queue) > 0 {
+		task := t.queue[0]
+		t.queue = t.queue[1:]
+		t.mu.Unlock()
+
+		task()
+
+		t.mu.Lock()
+	}
+	t.running = false
+	t.mu.Unlock()
+}
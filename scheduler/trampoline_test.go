@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrampoline(t *testing.T) {
+	tramp := NewTrampoline()
+	order := []string{}
+
+	var countdown func(n int)
+	countdown = func(n int) {
+		order = append(order, "start")
+		if n > 0 {
+			tramp.Schedule(func() {
+				countdown(n - 1)
+			})
+		}
+		order = append(order, "end")
+	}
+
+	tramp.Schedule(func() {
+		countdown(2)
+	})
+
+	// Each nested task only runs once the previous one has returned.
+	assert.Exactly(t, []string{"start", "end", "start", "end", "start", "end"}, order)
+}
+
+func TestTrampolineDeepRecursion(t *testing.T) {
+	tramp := NewTrampoline()
+	count := 0
+
+	var step func()
+	step = func() {
+		count++
+		if count < 1000000 {
+			tramp.Schedule(step)
+		}
+	}
+	tramp.Schedule(step)
+
+	assert.Equal(t, 1000000, count)
+}
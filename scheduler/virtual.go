@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// TestScheduler is a Scheduler and a Clock whose time only moves forward when
+// told to, so that time-based operators can be tested deterministically
+// without sleeping.
+type TestScheduler struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	timers  []*virtualTimer
+	pending []func()
+}
+
+type virtualTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewTestScheduler creates a TestScheduler starting at the Unix epoch.
+func NewTestScheduler() *TestScheduler {
+	s := &TestScheduler{now: time.Unix(0, 0)}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// Now registers TestScheduler to Clock. It returns the virtual time.
+func (s *TestScheduler) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// After registers TestScheduler to Clock. The returned channel receives once
+// the virtual time has been advanced by d.
+func (s *TestScheduler) After(d time.Duration) <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- s.now
+		return ch
+	}
+	s.timers = append(s.timers, &virtualTimer{at: s.now.Add(d), ch: ch})
+	s.cond.Broadcast()
+	return ch
+}
+
+// Schedule registers TestScheduler to Scheduler. The task runs on the
+// goroutine calling the next AdvanceBy or AdvanceTo.
+func (s *TestScheduler) Schedule(task func()) {
+	s.mu.Lock()
+	s.pending = append(s.pending, task)
+	s.mu.Unlock()
+}
+
+// BlockUntil waits until at least n timers are waiting for the virtual time to
+// advance. Operators start their timers on goroutines of their own, so a test
+// calls it before advancing the time to make sure they are in place.
+func (s *TestScheduler) BlockUntil(n int) {
+	s.mu.Lock()
+	for len(s.timers) < n {
+		s.cond.Wait()
+	}
+	s.mu.Unlock()
+}
+
+// AdvanceBy moves the virtual time forward by d, running the scheduled tasks
+// and firing the timers which are due, in order.
+func (s *TestScheduler) AdvanceBy(d time.Duration) {
+	s.AdvanceTo(s.Now().Add(d))
+}
+
+// AdvanceTo moves the virtual time forward to t, running the scheduled tasks
+// and firing the timers which are due, in order. The time never goes back.
+// Timers started in reaction, such as the next tick of an Interval, are left
+// for the next call.
+func (s *TestScheduler) AdvanceTo(t time.Time) {
+	s.mu.Lock()
+	tasks := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	for _, task := range tasks {
+		task()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sort.Stable(byDeadline(s.timers))
+	for len(s.timers) > 0 && !s.timers[0].at.After(t) {
+		timer := s.timers[0]
+		s.timers = s.timers[1:]
+		s.now = timer.at
+		timer.ch <- timer.at
+	}
+	if t.After(s.now) {
+		s.now = t
+	}
+}
+
+type byDeadline []*virtualTimer
+
+func (b byDeadline) Len() int           { return len(b) }
+func (b byDeadline) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byDeadline) Less(i, j int) bool { return b[i].at.Before(b[j].at) }
@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestSchedulerAfter(t *testing.T) {
+	s := NewTestScheduler()
+	start := s.Now()
+
+	late := s.After(2 * time.Second)
+	early := s.After(time.Second)
+
+	s.AdvanceBy(500 * time.Millisecond)
+	assert.Len(t, early, 0)
+
+	s.AdvanceBy(time.Second)
+	assert.Equal(t, start.Add(time.Second), <-early)
+	assert.Len(t, late, 0)
+	assert.Equal(t, start.Add(1500*time.Millisecond), s.Now())
+
+	s.AdvanceTo(start.Add(time.Hour))
+	assert.Equal(t, start.Add(2*time.Second), <-late)
+	assert.Equal(t, start.Add(time.Hour), s.Now())
+
+	s.AdvanceTo(start)
+	assert.Equal(t, start.Add(time.Hour), s.Now())
+}
+
+func TestTestSchedulerBlockUntil(t *testing.T) {
+	s := NewTestScheduler()
+	fired := make(chan time.Time)
+	go func() {
+		fired <- <-s.After(time.Minute)
+	}()
+
+	s.BlockUntil(1)
+	s.AdvanceBy(time.Minute)
+	assert.Equal(t, time.Unix(60, 0), <-fired)
+}
+
+func TestTestSchedulerSchedule(t *testing.T) {
+	s := NewTestScheduler()
+	ran := false
+	s.Schedule(func() {
+		ran = true
+	})
+	assert.False(t, ran)
+
+	s.AdvanceBy(0)
+	assert.True(t, ran)
+}
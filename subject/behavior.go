@@ -0,0 +1,42 @@
+package subject
+
+// BehaviorSubject is a Subject which holds the latest item pushed to it, or
+// an initial item, and emits it to every new subscriber before the items
+// pushed afterwards. It models state whose late subscribers need the current
+// snapshot. Once terminated, it no longer emits its latest item.
+type BehaviorSubject struct {
+	*Subject
+	latest *latest
+}
+
+// NewBehavior creates a BehaviorSubject holding an initial item.
+func NewBehavior(initial interface{}) *BehaviorSubject {
+	l := &latest{item: initial}
+	return &BehaviorSubject{
+		Subject: &Subject{cache: l},
+		latest:  l,
+	}
+}
+
+// Value returns the latest item pushed to the BehaviorSubject.
+func (s *BehaviorSubject) Value() interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest.item
+}
+
+// latest is a cache replaying the latest item until termination.
+type latest struct {
+	item interface{}
+}
+
+func (l *latest) add(item interface{}) {
+	l.item = item
+}
+
+func (l *latest) replay(done bool) []interface{} {
+	if done {
+		return nil
+	}
+	return []interface{}{l.item}
+}
@@ -0,0 +1,35 @@
+package subject
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBehaviorSubject(t *testing.T) {
+	s := NewBehavior(0)
+	first := s.Observable()
+	assert.Equal(t, 0, s.Value())
+
+	s.OnNext(1)
+	s.OnNext(2)
+	second := s.Observable()
+	assert.Equal(t, 2, s.Value())
+
+	s.OnNext(3)
+	s.OnDone()
+
+	assert.Exactly(t, []interface{}{0, 1, 2, 3}, drain(first))
+	assert.Exactly(t, []interface{}{2, 3}, drain(second))
+	assert.Exactly(t, []interface{}{}, drain(s.Observable()))
+}
+
+func TestBehaviorSubjectWithError(t *testing.T) {
+	s := NewBehavior(0)
+	myerr := errors.New("bang")
+	s.OnNext(1)
+	s.OnError(myerr)
+
+	assert.Exactly(t, []interface{}{myerr}, drain(s.Observable()))
+}
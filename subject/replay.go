@@ -0,0 +1,60 @@
+package subject
+
+import (
+	"time"
+)
+
+// ReplaySubject is a Subject which keeps the items pushed to it and emits
+// them to every new subscriber before the items pushed afterwards, even once
+// terminated. It covers late subscribers to event logs.
+type ReplaySubject struct {
+	*Subject
+}
+
+// NewReplay creates a ReplaySubject keeping up to bufferSize items, pushed
+// within the given window. A bufferSize or window of zero leaves it unbounded
+// by count or age respectively.
+func NewReplay(bufferSize int, window time.Duration) *ReplaySubject {
+	return &ReplaySubject{
+		Subject: &Subject{cache: &history{size: bufferSize, window: window}},
+	}
+}
+
+// history is a cache replaying the last items pushed within a window.
+type history struct {
+	size   int
+	window time.Duration
+	items  []interface{}
+	times  []time.Time
+}
+
+func (h *history) add(item interface{}) {
+	now := time.Now()
+	h.items = append(h.items, item)
+	h.times = append(h.times, now)
+	if h.size > 0 && len(h.items) > h.size {
+		h.items = h.items[len(h.items)-h.size:]
+		h.times = h.times[len(h.times)-h.size:]
+	}
+	h.prune(now)
+}
+
+func (h *history) replay(done bool) []interface{} {
+	h.prune(time.Now())
+	return h.items
+}
+
+// prune drops the items pushed before the window, so that the history of a
+// busy subject stays bounded even if nobody subscribes.
+func (h *history) prune(now time.Time) {
+	if h.window <= 0 {
+		return
+	}
+	oldest := now.Add(-h.window)
+	i := 0
+	for i < len(h.times) && h.times[i].Before(oldest) {
+		i++
+	}
+	h.items = h.items[i:]
+	h.times = h.times[i:]
+}
@@ -0,0 +1,56 @@
+package subject
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaySubject(t *testing.T) {
+	s := NewReplay(0, 0)
+	s.OnNext(1)
+	s.OnNext(2)
+	first := s.Observable()
+	s.OnNext(3)
+	s.OnDone()
+
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(first))
+	assert.Exactly(t, []interface{}{1, 2, 3}, drain(s.Observable()))
+}
+
+func TestReplaySubjectWithBufferSize(t *testing.T) {
+	s := NewReplay(2, 0)
+	for i := 1; i <= 5; i++ {
+		s.OnNext(i)
+	}
+	myerr := errors.New("bang")
+	s.OnError(myerr)
+
+	assert.Exactly(t, []interface{}{4, 5, myerr}, drain(s.Observable()))
+}
+
+func TestReplaySubjectWithWindow(t *testing.T) {
+	s := NewReplay(0, 20*time.Millisecond)
+	s.OnNext(1)
+	time.Sleep(30 * time.Millisecond)
+	s.OnNext(2)
+	s.OnDone()
+
+	assert.Exactly(t, []interface{}{2}, drain(s.Observable()))
+}
+
+func TestHistoryPrunesOnAdd(t *testing.T) {
+	h := &history{size: 3, window: 20 * time.Millisecond}
+	for i := 1; i <= 5; i++ {
+		h.add(i)
+	}
+	assert.Exactly(t, []interface{}{3, 4, 5}, h.items)
+	assert.Len(t, h.times, 3)
+
+	time.Sleep(30 * time.Millisecond)
+	h.add(6)
+	assert.Exactly(t, []interface{}{6}, h.items)
+	assert.Len(t, h.times, 1)
+}
@@ -0,0 +1,180 @@
+// Package subject provides Subjects, which are both an Observer and an
+// Observable multicasting the items pushed to them.
+package subject
+
+import (
+	"sync"
+
+	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/observer"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// Subject multicasts the items pushed with OnNext to all of its current
+// subscribers, until it is terminated by OnError or OnDone. A subscriber
+// arriving after the termination only receives the error, if any.
+//
+// Each subscriber is queued without bound, so that pushing to a Subject
+// never waits for a slow subscriber.
+type Subject struct {
+	mu          sync.Mutex
+	subscribers []chan<- interface{}
+	done        bool
+	err         error
+	cache       cache
+}
+
+// cache keeps the items replayed to a new subscriber of a Subject.
+type cache interface {
+	add(item interface{})
+	replay(done bool) []interface{}
+}
+
+// New creates a Subject without any subscriber.
+func New() *Subject {
+	return &Subject{}
+}
+
+// OnNext emits an item to every current subscriber.
+func (s *Subject) OnNext(item interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return
+	}
+	if s.cache != nil {
+		s.cache.add(item)
+	}
+	for _, sub := range s.subscribers {
+		sub <- item
+	}
+}
+
+// OnError emits an error to every current subscriber and terminates the
+// Subject.
+func (s *Subject) OnError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return
+	}
+	for _, sub := range s.subscribers {
+		sub <- err
+	}
+	s.err = err
+	s.terminate()
+}
+
+// OnDone completes every current subscriber and terminates the Subject.
+func (s *Subject) OnDone() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return
+	}
+	s.terminate()
+}
+
+// Handle registers Subject to EventHandler.
+func (s *Subject) Handle(item interface{}) {
+	switch item := item.(type) {
+	case error:
+		s.OnError(item)
+	default:
+		s.OnNext(item)
+	}
+}
+
+// Observer returns an Observer pushing to the Subject, so that it can
+// subscribe to an Observable.
+func (s *Subject) Observer() observer.Observer {
+	return observer.Observer{
+		NextHandler: s.OnNext,
+		ErrHandler:  s.OnError,
+		DoneHandler: s.OnDone,
+	}
+}
+
+// Observable returns a new Observable receiving the items pushed to the
+// Subject from now on, preceded by the items it replays, if any. It keeps
+// receiving them until the Subject terminates; see SubscribeDisposable to
+// leave earlier.
+func (s *Subject) Observable() observable.Observable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, out := s.add()
+	return out
+}
+
+// Subscribe subscribes an EventHandler to the items pushed to the Subject from
+// now on, preceded by the items it replays, if any, and returns a
+// Subscription channel.
+func (s *Subject) Subscribe(handler rx.EventHandler) <-chan subscription.Subscription {
+	_, done := s.SubscribeDisposable(handler)
+	return done
+}
+
+// SubscribeDisposable is like Subscribe but also returns the Subscription
+// right away. Disposing of it removes the subscriber from the Subject, which
+// no longer queues items for it.
+func (s *Subject) SubscribeDisposable(handler rx.EventHandler) (subscription.Subscription, <-chan subscription.Subscription) {
+	s.mu.Lock()
+	in, out := s.add()
+	s.mu.Unlock()
+
+	sub, subs := out.SubscribeDisposable(handler)
+	done := make(chan subscription.Subscription)
+	go func() {
+		// The subscriber is removed however its Subscription ends.
+		result := <-subs
+		if in != nil {
+			s.remove(in)
+		}
+		done <- result
+	}()
+	return sub, done
+}
+
+// add registers a new subscriber, or returns a nil channel along with the
+// Observable if the Subject has terminated; s.mu must be held.
+func (s *Subject) add() (chan<- interface{}, observable.Observable) {
+	in, out := observable.Unbounded()
+	if s.cache != nil {
+		for _, item := range s.cache.replay(s.done) {
+			in <- item
+		}
+	}
+	if s.err != nil {
+		in <- s.err
+	}
+	if s.done {
+		close(in)
+		return nil, out
+	}
+	s.subscribers = append(s.subscribers, in)
+	return in, out
+}
+
+// remove completes a subscriber and unregisters it, unless the Subject has
+// already terminated it.
+func (s *Subject) remove(in chan<- interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sub := range s.subscribers {
+		if sub == in {
+			close(sub)
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// terminate completes every subscriber; s.mu must be held.
+func (s *Subject) terminate() {
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.subscribers = nil
+	s.done = true
+}
@@ -0,0 +1,93 @@
+package subject
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/observer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drain(o observable.Observable) []interface{} {
+	items := []interface{}{}
+	for item := range o {
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestSubjectMulticast(t *testing.T) {
+	s := New()
+	first := s.Observable()
+	s.OnNext(1)
+	second := s.Observable()
+	s.OnNext(2)
+	s.OnDone()
+	s.OnNext(3)
+
+	assert.Exactly(t, []interface{}{1, 2}, drain(first))
+	assert.Exactly(t, []interface{}{2}, drain(second))
+	assert.Exactly(t, []interface{}{}, drain(s.Observable()))
+}
+
+func TestSubjectWithError(t *testing.T) {
+	s := New()
+	first := s.Observable()
+	myerr := errors.New("bang")
+	s.OnNext(1)
+	s.OnError(myerr)
+	s.OnError(errors.New("ignored"))
+
+	assert.Exactly(t, []interface{}{1, myerr}, drain(first))
+	assert.Exactly(t, []interface{}{myerr}, drain(s.Observable()))
+}
+
+func TestSubjectSubscribe(t *testing.T) {
+	s := New()
+
+	nums := []int{}
+	done := false
+	sub := s.Subscribe(observer.New(
+		handlers.NextFunc(func(item interface{}) {
+			nums = append(nums, item.(int))
+		}),
+		handlers.DoneFunc(func() {
+			done = true
+		}),
+	))
+
+	<-observable.Just(1, 2, 3).Subscribe(s.Observer())
+	<-sub
+
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+	assert.True(t, done)
+}
+
+func TestSubjectSubscribeDisposable(t *testing.T) {
+	s := New()
+
+	nums := make(chan int, 10)
+	sub, subs := s.SubscribeDisposable(handlers.NextFunc(func(item interface{}) {
+		nums <- item.(int)
+	}))
+	other := s.Observable()
+
+	s.OnNext(1)
+	assert.Equal(t, 1, <-nums)
+	assert.Equal(t, 1, <-other)
+	sub.Dispose()
+	<-subs
+
+	// The disposed subscriber is no longer queued items.
+	s.mu.Lock()
+	assert.Len(t, s.subscribers, 1)
+	s.mu.Unlock()
+
+	s.OnNext(2)
+	s.OnDone()
+	assert.Exactly(t, []interface{}{2}, drain(other))
+	assert.Len(t, nums, 0)
+}
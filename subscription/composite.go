@@ -0,0 +1,101 @@
+package subscription
+
+import "sync"
+
+// Disposable is implemented by anything which can be torn down, such as the
+// resources behind a stream.
+type Disposable interface {
+	Dispose()
+}
+
+type funcDisposable struct {
+	once sync.Once
+	fn   func()
+}
+
+// Dispose registers funcDisposable to Disposable.
+func (d *funcDisposable) Dispose() {
+	d.once.Do(d.fn)
+}
+
+// NewDisposable wraps a func, such as the dispose func returned by an
+// operator, into a Disposable calling it at most once.
+func NewDisposable(fn func()) Disposable {
+	return &funcDisposable{fn: fn}
+}
+
+// CompositeSubscription groups Disposables so that they can all be disposed
+// of at once. Disposables are compared with ==, so they must be comparable,
+// such as pointers.
+type CompositeSubscription struct {
+	mu          sync.Mutex
+	disposables []Disposable
+	disposed    bool
+}
+
+// NewComposite creates a CompositeSubscription of the given Disposables.
+func NewComposite(disposables ...Disposable) *CompositeSubscription {
+	return &CompositeSubscription{disposables: disposables}
+}
+
+// Add adds a Disposable to the group, or disposes of it right away if the
+// group has already been disposed of.
+func (c *CompositeSubscription) Add(d Disposable) {
+	c.mu.Lock()
+	if !c.disposed {
+		c.disposables = append(c.disposables, d)
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	d.Dispose()
+}
+
+// Remove removes a Disposable from the group and disposes of it. It reports
+// whether the Disposable was part of the group.
+func (c *CompositeSubscription) Remove(d Disposable) bool {
+	c.mu.Lock()
+	found := false
+	for i, other := range c.disposables {
+		if other == d {
+			c.disposables = append(c.disposables[:i], c.disposables[i+1:]...)
+			found = true
+			break
+		}
+	}
+	c.mu.Unlock()
+
+	if found {
+		d.Dispose()
+	}
+	return found
+}
+
+// Clear disposes of every Disposable in the group and empties it, leaving it
+// usable.
+func (c *CompositeSubscription) Clear() {
+	c.mu.Lock()
+	disposables := c.disposables
+	c.disposables = nil
+	c.mu.Unlock()
+
+	for _, d := range disposables {
+		d.Dispose()
+	}
+}
+
+// Dispose registers CompositeSubscription to Disposable. It disposes of every
+// Disposable in the group, as well as of those added afterwards.
+func (c *CompositeSubscription) Dispose() {
+	c.mu.Lock()
+	c.disposed = true
+	c.mu.Unlock()
+	c.Clear()
+}
+
+// Len returns the number of Disposables in the group.
+func (c *CompositeSubscription) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.disposables)
+}
@@ -0,0 +1,53 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisposable(t *testing.T) {
+	count := 0
+	d := NewDisposable(func() {
+		count++
+	})
+
+	d.Dispose()
+	d.Dispose()
+	assert.Equal(t, 1, count)
+}
+
+func TestCompositeSubscription(t *testing.T) {
+	disposed := []string{}
+	named := func(name string) Disposable {
+		return NewDisposable(func() {
+			disposed = append(disposed, name)
+		})
+	}
+
+	a, b, c := named("a"), named("b"), named("c")
+	composite := NewComposite(a, b)
+	composite.Add(c)
+	assert.Equal(t, 3, composite.Len())
+
+	assert.True(t, composite.Remove(b))
+	assert.False(t, composite.Remove(b))
+	assert.Exactly(t, []string{"b"}, disposed)
+	assert.Equal(t, 2, composite.Len())
+
+	composite.Clear()
+	assert.Exactly(t, []string{"b", "a", "c"}, disposed)
+	assert.Equal(t, 0, composite.Len())
+
+	// A cleared group is still usable.
+	composite.Add(named("d"))
+	assert.Equal(t, 1, composite.Len())
+
+	composite.Dispose()
+	assert.Exactly(t, []string{"b", "a", "c", "d"}, disposed)
+
+	// A disposed group disposes of anything added to it.
+	composite.Add(named("e"))
+	assert.Exactly(t, []string{"b", "a", "c", "d", "e"}, disposed)
+	assert.Equal(t, 0, composite.Len())
+}
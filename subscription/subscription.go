@@ -1,13 +1,25 @@
 package subscription
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 // Subscription is usually returned from any subscription
 type Subscription struct {
 	SubscribeAt   time.Time
 	UnsubscribeAt time.Time
 	Error         error
-	//term          chan struct{}
+	term          *terminator
+}
+
+// terminator is shared by the copies of a Subscription, so that disposing of
+// any of them is seen by all.
+type terminator struct {
+	mu        sync.Mutex
+	ch        chan struct{}
+	disposed  bool
+	callbacks []func()
 }
 
 // DefaultSubscription is a default Subscription.
@@ -23,9 +35,13 @@ func (s Subscription) Err() error {
 	return s.Error
 }
 
-// Subscribe records the time of subscription.
+// Subscribe records the time of subscription and makes the Subscription
+// disposable.
 func (s Subscription) Subscribe() Subscription {
 	s.SubscribeAt = time.Now()
+	if s.term == nil {
+		s.term = &terminator{ch: make(chan struct{})}
+	}
 	return s
 }
 
@@ -35,16 +51,68 @@ func (s Subscription) Unsubscribe() Subscription {
 	return s
 }
 
-/* TODO:
+// Dispose registers Subscription to Disposable. It signals the goroutine
+// reading the stream to stop, which in turn stops delivering to the
+// EventHandler. Disposing of a Subscription many times, or of one which has
+// never been subscribed, has no effect.
 func (s Subscription) Dispose() {
-	s.term <- struct{}{}
-	close(s.term)
+	if s.term == nil {
+		return
+	}
+	s.term.mu.Lock()
+	if s.term.disposed {
+		s.term.mu.Unlock()
+		return
+	}
+	s.term.disposed = true
+	close(s.term.ch)
+	callbacks := s.term.callbacks
+	s.term.callbacks = nil
+	s.term.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn()
+	}
 }
 
-func (s Subscription) Terminated() chan struct{} {
-	return s.term
+// IsDisposed reports whether the Subscription has been disposed of.
+func (s Subscription) IsDisposed() bool {
+	if s.term == nil {
+		return false
+	}
+	s.term.mu.Lock()
+	defer s.term.mu.Unlock()
+	return s.term.disposed
 }
 
+// OnDispose registers a func called once the Subscription is disposed of, or
+// right away if it already is, such as to close a connection opened for the
+// stream. It has no effect on a Subscription which has never been subscribed.
+func (s Subscription) OnDispose(fn func()) {
+	if s.term == nil {
+		return
+	}
+	s.term.mu.Lock()
+	if !s.term.disposed {
+		s.term.callbacks = append(s.term.callbacks, fn)
+		s.term.mu.Unlock()
+		return
+	}
+	s.term.mu.Unlock()
+	fn()
+}
+
+// Terminated returns a channel which is closed once the Subscription is
+// disposed of. It is nil, and thus never ready, if the Subscription has never
+// been subscribed.
+func (s Subscription) Terminated() <-chan struct{} {
+	if s.term == nil {
+		return nil
+	}
+	return s.term.ch
+}
+
+/* TODO:
 // UnscribeIn notify the unsubscribe channel in d duration, then return the Subscriptor
 func (s *Subscription) UnsubscribeIn(d time.Duration) <-chan bases.Subscriptor {
 	out := make(chan bases.Subscriptor)
@@ -30,3 +30,45 @@ func TestSubscription(t *testing.T) {
 	assert.WithinDuration(first, sub.SubscribeAt, 5*time.Millisecond)
 	assert.WithinDuration(first, sub.SubscribeAt, 15*time.Millisecond)
 }
+
+func TestSubscriptionDispose(t *testing.T) {
+	New().Dispose()
+	assert.Nil(t, New().Terminated())
+
+	sub := New().Subscribe()
+	copied := sub.Unsubscribe()
+	select {
+	case <-sub.Terminated():
+		assert.Fail(t, "terminated before Dispose")
+	default:
+	}
+
+	copied.Dispose()
+	copied.Dispose()
+	_, ok := <-sub.Terminated()
+	assert.False(t, ok)
+
+	composite := NewComposite(sub)
+	composite.Dispose()
+}
+
+func TestSubscriptionOnDispose(t *testing.T) {
+	sub := New().Subscribe()
+	assert.False(t, sub.IsDisposed())
+
+	closed := []string{}
+	sub.OnDispose(func() {
+		closed = append(closed, "conn")
+	})
+	sub.Dispose()
+	sub.Dispose()
+	assert.True(t, sub.IsDisposed())
+	assert.Exactly(t, []string{"conn"}, closed)
+
+	sub.OnDispose(func() {
+		closed = append(closed, "late")
+	})
+	assert.Exactly(t, []string{"conn", "late"}, closed)
+
+	assert.False(t, New().IsDisposed())
+}
@@ -0,0 +1,280 @@
+// Package supervisor provides a Supervisor which restarts Observables when
+// they terminate, following Erlang-style restart policies.
+package supervisor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/reactivex/rxgo"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/scheduler"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// RestartPolicy tells when a supervised Observable gets restarted.
+type RestartPolicy int
+
+const (
+	// Always restarts an Observable whether it errors or completes.
+	Always RestartPolicy = iota
+	// OnFailure restarts an Observable only when it errors.
+	OnFailure
+	// Never lets an Observable terminate.
+	Never
+)
+
+// Spec describes an Observable to supervise.
+type Spec struct {
+	// Name identifies the Observable in the lifecycle Events.
+	Name string
+
+	// Factory creates a fresh Observable for every (re)start.
+	Factory func() observable.Observable
+
+	// Handler is subscribed to every Observable created by Factory.
+	Handler rx.EventHandler
+
+	// Restart is the RestartPolicy, Always by default.
+	Restart RestartPolicy
+
+	// Backoff, if set, delays restarts. Its attempt count is the number of
+	// restarts within Window.
+	Backoff observable.BackoffPolicy
+
+	// MaxRestarts is the number of restarts allowed within Window before
+	// the Supervisor gives up, or no limit if zero.
+	MaxRestarts int
+
+	// Window is the sliding time window counting restarts.
+	Window time.Duration
+}
+
+// EventType is the type of a lifecycle Event.
+type EventType int
+
+const (
+	// Started is emitted every time an Observable is subscribed.
+	Started EventType = iota
+	// Completed is emitted when an Observable completes.
+	Completed
+	// Failed is emitted when an Observable errors.
+	Failed
+	// Restarting is emitted before an Observable gets restarted.
+	Restarting
+	// GaveUp is emitted when an Observable exceeds its restart intensity.
+	GaveUp
+)
+
+// String returns the name of an EventType.
+func (t EventType) String() string {
+	switch t {
+	case Started:
+		return "Started"
+	case Completed:
+		return "Completed"
+	case Failed:
+		return "Failed"
+	case Restarting:
+		return "Restarting"
+	case GaveUp:
+		return "GaveUp"
+	default:
+		return fmt.Sprintf("EventType(%d)", int(t))
+	}
+}
+
+// Event is a lifecycle event of a supervised Observable.
+type Event struct {
+	Name     string
+	Type     EventType
+	Err      error
+	Restarts int
+	Time     time.Time
+}
+
+// Supervisor owns a set of supervised Observables.
+type Supervisor struct {
+	mu       sync.Mutex
+	stopped  bool
+	stop     chan struct{}
+	running  int
+	children map[*Spec]subscription.Subscription
+	wg       sync.WaitGroup
+	queue    []interface{}
+	wake     chan struct{}
+	events   chan interface{}
+	clock    scheduler.Clock
+}
+
+// New creates a Supervisor with no Observable. It times restarts and Events
+// with the current Clock.
+func New() *Supervisor {
+	s := &Supervisor{
+		stop:     make(chan struct{}),
+		children: make(map[*Spec]subscription.Subscription),
+		wake:     make(chan struct{}, 1),
+		events:   make(chan interface{}),
+		clock:    observable.CurrentClock(),
+	}
+	go s.deliver()
+	return s
+}
+
+// Events returns an Observable of the lifecycle Events of every supervised
+// Observable. Events are queued without bound so that a slow consumer never
+// delays restarts, hence the Observable must be read until it completes,
+// once the Supervisor is stopped and every Observable terminated.
+func (s *Supervisor) Events() observable.Observable {
+	return observable.Observable(s.events)
+}
+
+// Add starts supervising the Observables created by a Spec.
+func (s *Supervisor) Add(spec Spec) {
+	s.mu.Lock()
+	s.running++
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.supervise(spec)
+}
+
+// Stop prevents any further restart, including one waiting for its backoff,
+// and disposes of the Subscriptions to the Observables which are running.
+func (s *Supervisor) Stop() {
+	s.mu.Lock()
+	if !s.stopped {
+		s.stopped = true
+		close(s.stop)
+	}
+	children := make([]subscription.Subscription, 0, len(s.children))
+	for _, sub := range s.children {
+		children = append(children, sub)
+	}
+	s.mu.Unlock()
+
+	for _, sub := range children {
+		sub.Dispose()
+	}
+	s.notify()
+}
+
+// Wait blocks until every supervised Observable has terminated without
+// being restarted.
+func (s *Supervisor) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Supervisor) isStopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stopped
+}
+
+func (s *Supervisor) supervise(spec Spec) {
+	defer func() {
+		s.mu.Lock()
+		s.running--
+		s.mu.Unlock()
+		s.notify()
+		s.wg.Done()
+	}()
+
+	restarts := []time.Time{}
+	total := 0
+	for {
+		s.emit(Event{Name: spec.Name, Type: Started, Restarts: total})
+		child, subs := spec.Factory().SubscribeDisposable(spec.Handler)
+		s.mu.Lock()
+		if s.stopped {
+			child.Dispose()
+		}
+		s.children[&spec] = child
+		s.mu.Unlock()
+
+		sub := <-subs
+		s.mu.Lock()
+		delete(s.children, &spec)
+		s.mu.Unlock()
+
+		err := sub.Err()
+		if err != nil {
+			s.emit(Event{Name: spec.Name, Type: Failed, Err: err, Restarts: total})
+		} else {
+			s.emit(Event{Name: spec.Name, Type: Completed, Restarts: total})
+		}
+
+		if spec.Restart == Never || (spec.Restart == OnFailure && err == nil) || s.isStopped() {
+			return
+		}
+
+		now := s.clock.Now()
+		recent := restarts[:0]
+		for _, at := range restarts {
+			if spec.Window <= 0 || now.Sub(at) < spec.Window {
+				recent = append(recent, at)
+			}
+		}
+		restarts = append(recent, now)
+
+		if spec.MaxRestarts > 0 && len(restarts) > spec.MaxRestarts {
+			s.emit(Event{Name: spec.Name, Type: GaveUp, Err: err, Restarts: total})
+			return
+		}
+
+		if spec.Backoff != nil {
+			delay, retry := spec.Backoff.Backoff(len(restarts))
+			if !retry {
+				s.emit(Event{Name: spec.Name, Type: GaveUp, Err: err, Restarts: total})
+				return
+			}
+			select {
+			case <-s.clock.After(delay):
+			case <-s.stop:
+				return
+			}
+		}
+
+		total++
+		s.emit(Event{Name: spec.Name, Type: Restarting, Err: err, Restarts: total})
+	}
+}
+
+func (s *Supervisor) emit(ev Event) {
+	ev.Time = s.clock.Now()
+	s.mu.Lock()
+	s.queue = append(s.queue, ev)
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *Supervisor) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliver emits the queued Events until the Supervisor is stopped and
+// every Observable terminated.
+func (s *Supervisor) deliver() {
+	for range s.wake {
+		for {
+			s.mu.Lock()
+			if len(s.queue) == 0 {
+				finished := s.stopped && s.running == 0
+				s.mu.Unlock()
+				if finished {
+					close(s.events)
+					return
+				}
+				break
+			}
+			ev := s.queue[0]
+			s.queue = s.queue[1:]
+			s.mu.Unlock()
+			s.events <- ev
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package supervisor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/scheduler"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect(s *Supervisor) <-chan []EventType {
+	types := make(chan []EventType)
+	go func() {
+		collected := []EventType{}
+		for ev := range s.Events() {
+			collected = append(collected, ev.(Event).Type)
+		}
+		types <- collected
+	}()
+	return types
+}
+
+func TestSupervisorOnFailure(t *testing.T) {
+	s := New()
+	types := collect(s)
+
+	runs := 0
+	nums := []int{}
+	s.Add(Spec{
+		Name: "flaky",
+		Factory: func() observable.Observable {
+			runs++
+			if runs < 3 {
+				return observable.Just(runs, errors.New("bang"))
+			}
+			return observable.Just(runs)
+		},
+		Handler: handlers.NextFunc(func(item interface{}) {
+			nums = append(nums, item.(int))
+		}),
+		Restart: OnFailure,
+	})
+
+	s.Wait()
+	s.Stop()
+
+	assert.Exactly(t, []int{1, 2, 3}, nums)
+	assert.Exactly(t, []EventType{
+		Started, Failed, Restarting,
+		Started, Failed, Restarting,
+		Started, Completed,
+	}, <-types)
+}
+
+func TestSupervisorGivesUp(t *testing.T) {
+	s := New()
+	types := collect(s)
+
+	runs := 0
+	s.Add(Spec{
+		Name: "broken",
+		Factory: func() observable.Observable {
+			runs++
+			return observable.Just(errors.New("bang"))
+		},
+		Backoff:     observable.ConstantBackoff{Delay: time.Millisecond},
+		MaxRestarts: 2,
+		Window:      time.Minute,
+	})
+
+	s.Wait()
+	s.Stop()
+
+	assert.Equal(t, 3, runs)
+	assert.Exactly(t, []EventType{
+		Started, Failed, Restarting,
+		Started, Failed, Restarting,
+		Started, Failed, GaveUp,
+	}, <-types)
+}
+
+func TestSupervisorStop(t *testing.T) {
+	s := New()
+	types := collect(s)
+
+	source := make(chan interface{})
+	s.Add(Spec{
+		Name: "once",
+		Factory: func() observable.Observable {
+			return observable.Observable(source)
+		},
+	})
+	s.Stop()
+	close(source)
+
+	assert.Exactly(t, []EventType{Started, Completed}, <-types)
+}
+
+// signalingBackoff waits an hour, and signals when it is asked to.
+type signalingBackoff chan struct{}
+
+func (b signalingBackoff) Backoff(attempt int) (time.Duration, bool) {
+	close(b)
+	return time.Hour, true
+}
+
+func TestSupervisorStopDuringBackoff(t *testing.T) {
+	s := New()
+	types := collect(s)
+
+	runs := 0
+	backoff := make(signalingBackoff)
+	s.Add(Spec{
+		Name: "broken",
+		Factory: func() observable.Observable {
+			runs++
+			return observable.Just(errors.New("bang"))
+		},
+		Backoff: backoff,
+	})
+
+	<-backoff
+	s.Stop()
+
+	waited := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		assert.Fail(t, "backoff not interrupted by Stop")
+	}
+
+	assert.Equal(t, 1, runs)
+	assert.Exactly(t, []EventType{Started, Failed}, <-types)
+}
+
+func TestSupervisorStopDisposes(t *testing.T) {
+	s := New()
+	types := collect(s)
+
+	started := make(chan struct{})
+	s.Add(Spec{
+		Name: "ticking",
+		Factory: func() observable.Observable {
+			close(started)
+			return observable.Interval(nil, time.Millisecond)
+		},
+	})
+	<-started
+	s.Stop()
+
+	waited := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		assert.Fail(t, "running Observable not disposed of by Stop")
+	}
+	assert.Exactly(t, []EventType{Started, Completed}, <-types)
+}
+
+func TestSupervisorClock(t *testing.T) {
+	clock := scheduler.NewTestScheduler()
+	observable.SetClock(clock)
+	defer observable.SetClock(nil)
+
+	s := New()
+	events := make(chan []Event)
+	go func() {
+		collected := []Event{}
+		for ev := range s.Events() {
+			collected = append(collected, ev.(Event))
+		}
+		events <- collected
+	}()
+
+	runs := 0
+	s.Add(Spec{
+		Name: "flaky",
+		Factory: func() observable.Observable {
+			runs++
+			if runs < 2 {
+				return observable.Just(errors.New("bang"))
+			}
+			return observable.Empty()
+		},
+		Restart: OnFailure,
+		Backoff: observable.ConstantBackoff{Delay: time.Minute},
+	})
+
+	clock.BlockUntil(1)
+	clock.AdvanceBy(time.Minute)
+	s.Wait()
+	s.Stop()
+
+	collected := <-events
+	if assert.Len(t, collected, 5) {
+		assert.Equal(t, time.Unix(0, 0), collected[1].Time)
+		assert.Equal(t, Restarting, collected[2].Type)
+		assert.Equal(t, time.Unix(60, 0), collected[2].Time)
+	}
+}
+
+func TestEventTypeString(t *testing.T) {
+	assert.Equal(t, "GaveUp", GaveUp.String())
+	assert.Equal(t, "EventType(9)", EventType(9).String())
+}
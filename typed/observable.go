@@ -0,0 +1,93 @@
+//go:build go1.18
+// +build go1.18
+
+// Package typed provides a type-parameterized layer over the observable
+// package, so that the type of the items is checked at compile time instead
+// of asserted in every handler. It is built on the interface{}-based API,
+// which remains available through Observable.Untyped.
+package typed
+
+import (
+	"github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/reactivex/rxgo/subscription"
+)
+
+// Observable is a stream of items of type T. As with observable.Observable,
+// an error terminates the stream.
+type Observable[T any] struct {
+	source observable.Observable
+}
+
+// FromObservable wraps an interface{}-based Observable expected to emit items
+// of type T. An item of any other type emits an error in its place and
+// terminates the new Observable.
+func FromObservable[T any](o observable.Observable) Observable[T] {
+	out := make(chan interface{})
+	observable.Link(out, o)
+	go func() {
+		for item := range o {
+			if _, isErr := item.(error); !isErr {
+				if _, ok := item.(T); !ok {
+					item = errors.New(errors.ObservableError, "unexpected item type")
+				}
+			}
+			out <- item
+			if _, isErr := item.(error); isErr {
+				break
+			}
+		}
+		observable.CloseOut(out)
+	}()
+	return Observable[T]{observable.Observable(out)}
+}
+
+// Just creates an Observable with the provided item(s).
+func Just[T any](item T, items ...T) Observable[T] {
+	all := make([]interface{}, len(items))
+	for i, item := range items {
+		all[i] = item
+	}
+	return Observable[T]{observable.Just(item, all...)}
+}
+
+// Untyped returns the underlying interface{}-based Observable, which emits
+// items of type T and errors only.
+func (o Observable[T]) Untyped() observable.Observable {
+	return o.source
+}
+
+// Subscribe subscribes an Observer and returns a Subscription channel.
+func (o Observable[T]) Subscribe(ob Observer[T]) <-chan subscription.Subscription {
+	return o.source.Subscribe(ob.untyped())
+}
+
+// Map applies a function to each item in the Observable.
+func (o Observable[T]) Map(apply func(T) T) Observable[T] {
+	return Observable[T]{o.source.Map(func(item interface{}) interface{} {
+		return apply(item.(T))
+	})}
+}
+
+// Filter emits only the items in the Observable which satisfy the predicate.
+func (o Observable[T]) Filter(apply func(T) bool) Observable[T] {
+	return Observable[T]{o.source.Filter(func(item interface{}) bool {
+		return apply(item.(T))
+	})}
+}
+
+// Take takes the first n items in the Observable.
+func (o Observable[T]) Take(nth uint) Observable[T] {
+	return Observable[T]{o.source.Take(nth)}
+}
+
+// ToSlice blocks until the Observable completes and returns its items, or the
+// error it terminated with along with the items emitted before.
+func (o Observable[T]) ToSlice() ([]T, error) {
+	items, err := o.source.ToSlice()
+	typed := make([]T, len(items))
+	for i, item := range items {
+		typed[i] = item.(T)
+	}
+	return typed, err
+}
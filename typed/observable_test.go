@@ -0,0 +1,66 @@
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	rxerrors "github.com/reactivex/rxgo/errors"
+	"github.com/reactivex/rxgo/observable"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservableSubscribe(t *testing.T) {
+	words := []string{}
+	done := false
+	ob := Observer[string]{
+		NextHandler: func(word string) {
+			words = append(words, strings.ToUpper(word))
+		},
+		DoneHandler: func() {
+			done = true
+		},
+	}
+
+	<-Just("foo", "bar").Subscribe(ob)
+
+	assert.Exactly(t, []string{"FOO", "BAR"}, words)
+	assert.True(t, done)
+}
+
+func TestObservableMapFilterTake(t *testing.T) {
+	nums, err := Just(1, 2, 3, 4, 5).
+		Filter(func(n int) bool { return n%2 == 1 }).
+		Map(func(n int) int { return n * 10 }).
+		Take(2).
+		ToSlice()
+
+	assert.NoError(t, err)
+	assert.Exactly(t, []int{10, 30}, nums)
+}
+
+func TestFromObservable(t *testing.T) {
+	nums, err := FromObservable[int](observable.Just(1, 2)).ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []int{1, 2}, nums)
+
+	nums, err = FromObservable[int](observable.Just(1, "2", 3)).ToSlice()
+	assert.Exactly(t, []int{1}, nums)
+	if assert.IsType(t, rxerrors.BaseError{}, err) {
+		assert.Equal(t, int(rxerrors.ObservableError), err.(rxerrors.BaseError).Code())
+	}
+
+	myerr := errors.New("bang")
+	nums, err = FromObservable[int](observable.Just(1, myerr)).ToSlice()
+	assert.Exactly(t, []int{1}, nums)
+	assert.Equal(t, myerr, err)
+}
+
+func TestObservableUntyped(t *testing.T) {
+	items, err := Just(1, 2).Untyped().ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []interface{}{1, 2}, items)
+}
@@ -0,0 +1,48 @@
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import (
+	"github.com/reactivex/rxgo/handlers"
+	"github.com/reactivex/rxgo/observer"
+)
+
+// NextFunc handles a next item of type T in a stream.
+type NextFunc[T any] func(T)
+
+// Handle registers NextFunc to EventHandler. Items which are not of type T,
+// errors included, are ignored.
+func (handle NextFunc[T]) Handle(item interface{}) {
+	if item, ok := item.(T); ok {
+		handle(item)
+	}
+}
+
+// Observer represents a group of handlers for a stream of items of type T.
+type Observer[T any] struct {
+	NextHandler NextFunc[T]
+	ErrHandler  handlers.ErrFunc
+	DoneHandler handlers.DoneFunc
+}
+
+// Handle registers Observer to EventHandler.
+func (ob Observer[T]) Handle(item interface{}) {
+	ob.untyped().Handle(item)
+}
+
+// untyped converts the Observer into an observer.Observer whose missing
+// handlers do nothing.
+func (ob Observer[T]) untyped() observer.Observer {
+	untyped := observer.DefaultObserver
+	if ob.NextHandler != nil {
+		untyped.NextHandler = ob.NextHandler.Handle
+	}
+	if ob.ErrHandler != nil {
+		untyped.ErrHandler = ob.ErrHandler
+	}
+	if ob.DoneHandler != nil {
+		untyped.DoneHandler = ob.DoneHandler
+	}
+	return untyped
+}
@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextFunc(t *testing.T) {
+	sum := 0
+	onNext := NextFunc[int](func(n int) {
+		sum += n
+	})
+
+	onNext.Handle(1)
+	onNext.Handle("2")
+	onNext.Handle(errors.New("bang"))
+	onNext.Handle(3)
+
+	assert.Equal(t, 4, sum)
+}
+
+func TestObserver(t *testing.T) {
+	nums := []int{}
+	var err error
+	ob := Observer[int]{
+		NextHandler: func(n int) {
+			nums = append(nums, n)
+		},
+		ErrHandler: func(e error) {
+			err = e
+		},
+	}
+
+	myerr := errors.New("bang")
+	ob.Handle(1)
+	ob.Handle(myerr)
+
+	assert.Exactly(t, []int{1}, nums)
+	assert.Equal(t, myerr, err)
+}
@@ -0,0 +1,37 @@
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+// MapT applies a function turning each item of type T in the original
+// Observable into an item of type R.
+// An error is passed on and terminates the new Observable.
+func MapT[T, R any](o Observable[T], apply func(T) R) Observable[R] {
+	return Observable[R]{o.source.Map(func(item interface{}) interface{} {
+		return apply(item.(T))
+	})}
+}
+
+// FilterT emits only the items in the original Observable which satisfy the
+// predicate. It is the free function counterpart of Observable.Filter.
+func FilterT[T any](o Observable[T], apply func(T) bool) Observable[T] {
+	return o.Filter(apply)
+}
+
+// ScanT accumulates the items of type T in the original Observable into a
+// value of type R, starting from seed, and emits each successive value.
+// An error is passed on and terminates the new Observable.
+func ScanT[T, R any](o Observable[T], apply func(R, T) R, seed R) Observable[R] {
+	return Observable[R]{o.source.Scan(func(acc, item interface{}) interface{} {
+		return apply(acc.(R), item.(T))
+	}, seed)}
+}
+
+// ReduceT accumulates the items of type T in the original Observable into a
+// value of type R, starting from seed, and emits only the final value once the
+// original Observable completes. An error is passed on instead.
+func ReduceT[T, R any](o Observable[T], apply func(R, T) R, seed R) Observable[R] {
+	return Observable[R]{o.source.Reduce(func(acc, item interface{}) interface{} {
+		return apply(acc.(R), item.(T))
+	}, seed)}
+}
@@ -0,0 +1,55 @@
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/reactivex/rxgo/observable"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapT(t *testing.T) {
+	words, err := MapT(Just(1, 2, 3), strconv.Itoa).ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []string{"1", "2", "3"}, words)
+
+	myerr := errors.New("bang")
+	words, err = MapT(FromObservable[int](observable.Just(1, myerr)), strconv.Itoa).ToSlice()
+	assert.Exactly(t, []string{"1"}, words)
+	assert.Equal(t, myerr, err)
+}
+
+func TestFilterT(t *testing.T) {
+	nums, err := FilterT(Just(1, 2, 3, 4), func(n int) bool {
+		return n > 2
+	}).ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []int{3, 4}, nums)
+}
+
+func TestScanT(t *testing.T) {
+	lengths, err := ScanT(Just("a", "bb", "ccc"), func(acc int, word string) int {
+		return acc + len(word)
+	}, 0).ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []int{1, 3, 6}, lengths)
+}
+
+func TestReduceT(t *testing.T) {
+	total, err := ReduceT(Just("a", "bb", "ccc"), func(acc int, word string) int {
+		return acc + len(word)
+	}, 0).ToSlice()
+	assert.NoError(t, err)
+	assert.Exactly(t, []int{6}, total)
+
+	myerr := errors.New("bang")
+	total, err = ReduceT(FromObservable[string](observable.Just("a", myerr)), func(acc int, word string) int {
+		return acc + len(word)
+	}, 0).ToSlice()
+	assert.Empty(t, total)
+	assert.Equal(t, myerr, err)
+}